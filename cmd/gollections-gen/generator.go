@@ -0,0 +1,318 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// The ways the generated Less method compares the values of the less field.
+const (
+	// compareOperator compares the values using the < operator
+	compareOperator = "operator"
+	// compareTime compares time.Time values using their Before method
+	compareTime = "time"
+	// compareBytes compares []byte values using bytes.Compare
+	compareBytes = "bytes"
+)
+
+// orderedTypes are the predeclared types supporting the < operator.
+var orderedTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+	"float32": true, "float64": true, "string": true, "byte": true, "rune": true,
+}
+
+// spec describes the container implementation to be generated.
+type spec struct {
+	Package string
+	Type    string
+	Key     string
+	Less    string
+	// Compare is the way the values of the Less field are compared, one of the compareX constants
+	Compare string
+}
+
+// Receiver returns the receiver name used by the generated methods.
+func (s spec) Receiver() string {
+	return string(unicode.ToLower([]rune(s.Type)[0]))
+}
+
+// typeDecl is a type declared in the parsed package, along with the file declaring it.
+type typeDecl struct {
+	spec *ast.TypeSpec
+	file *ast.File
+}
+
+// parseSpec parses the Go package in dir, and verifies that typeName is a struct type having the fields provided,
+// whose key field is comparable and whose less field is ordered.
+func parseSpec(dir, typeName, keyField, lessField string) (spec, error) {
+	if lessField == "" {
+		lessField = keyField
+	}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return spec{}, err
+	}
+	for pkgName, pkg := range pkgs {
+		decls := collectTypes(pkg)
+		decl, found := decls[typeName]
+		if !found {
+			continue
+		}
+		structType, ok := decl.spec.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+		keyType := fieldType(structType, keyField)
+		if keyType == nil {
+			return spec{}, fmt.Errorf("type %s has no field %s", typeName, keyField)
+		}
+		lessType := fieldType(structType, lessField)
+		if lessType == nil {
+			return spec{}, fmt.Errorf("type %s has no field %s", typeName, lessField)
+		}
+		if !isComparable(keyType, decls, 0) {
+			return spec{}, fmt.Errorf("field %s of type %s cannot be used as a key, since it is not comparable",
+				keyField, types.ExprString(keyType))
+		}
+		compare, ok := comparison(lessType, decl.file, decls, 0)
+		if !ok {
+			return spec{}, fmt.Errorf("field %s of type %s cannot be compared by Less; the field must be of a "+
+				"numeric, string, time.Time or []byte type", lessField, types.ExprString(lessType))
+		}
+		return spec{Package: pkgName, Type: typeName, Key: keyField, Less: lessField, Compare: compare}, nil
+	}
+	return spec{}, fmt.Errorf("struct type %s not found in %s", typeName, dir)
+}
+
+// collectTypes returns the types declared at the top level of the package, by their name.
+func collectTypes(pkg *ast.Package) map[string]typeDecl {
+	decls := make(map[string]typeDecl)
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range genDecl.Specs {
+				typeSpec := s.(*ast.TypeSpec)
+				decls[typeSpec.Name.Name] = typeDecl{spec: typeSpec, file: file}
+			}
+		}
+	}
+	return decls
+}
+
+// fieldType returns the type of the struct type's named field with the name provided, nil if not found.
+func fieldType(structType *ast.StructType, fieldName string) ast.Expr {
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				return field.Type
+			}
+		}
+	}
+	return nil
+}
+
+// maxTypeDepth bounds the resolution of the types declared in the package, guarding against invalid recursive types
+const maxTypeDepth = 16
+
+// comparison returns the way the values of the type can be compared by the generated Less method, false if they
+// cannot be ordered. Types declared in the package are resolved to their underlying types.
+func comparison(expr ast.Expr, file *ast.File, decls map[string]typeDecl, depth int) (string, bool) {
+	switch t := expr.(type) {
+	case *ast.ParenExpr:
+		return comparison(t.X, file, decls, depth)
+	case *ast.Ident:
+		if decl, found := decls[t.Name]; found {
+			if depth >= maxTypeDepth {
+				return "", false
+			}
+			compare, ok := comparison(decl.spec.Type, decl.file, decls, depth+1)
+			// A defined type doesn't inherit the methods of its underlying type, unlike an alias
+			if compare == compareTime && !decl.spec.Assign.IsValid() {
+				return "", false
+			}
+			return compare, ok
+		}
+		if orderedTypes[t.Name] {
+			return compareOperator, true
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok && pkg.Name == importName(file, "time") && t.Sel.Name == "Time" {
+			return compareTime, true
+		}
+	case *ast.ArrayType:
+		if elt, ok := t.Elt.(*ast.Ident); ok && t.Len == nil && (elt.Name == "byte" || elt.Name == "uint8") {
+			return compareBytes, true
+		}
+	}
+	return "", false
+}
+
+// isComparable reports whether the values of the type can be used as map keys, i.e., whether the type is neither a
+// slice, a map nor a function. Types declared in the package are resolved to their underlying types.
+func isComparable(expr ast.Expr, decls map[string]typeDecl, depth int) bool {
+	switch t := expr.(type) {
+	case *ast.ParenExpr:
+		return isComparable(t.X, decls, depth)
+	case *ast.Ident:
+		if decl, found := decls[t.Name]; found {
+			return depth < maxTypeDepth && isComparable(decl.spec.Type, decls, depth+1)
+		}
+	case *ast.ArrayType:
+		return t.Len != nil && isComparable(t.Elt, decls, depth)
+	case *ast.MapType, *ast.FuncType:
+		return false
+	}
+	return true
+}
+
+// importName returns the name the package with the import path provided is imported as in the file, an empty string
+// if the file doesn't import it.
+func importName(file *ast.File, path string) string {
+	for _, spec := range file.Imports {
+		if strings.Trim(spec.Path.Value, `"`) != path {
+			continue
+		}
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+		return path[strings.LastIndex(path, "/")+1:]
+	}
+	return ""
+}
+
+// generate returns the formatted source code of the container implementation.
+func generate(s spec) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := containerTemplate.Execute(&buf, s); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %s", err)
+	}
+	return src, nil
+}
+
+// lessTemplate is the expression comparing the less field of the receiver with the target's
+const lessTemplate = `{{define "less"}}
+{{- if eq .Compare "time"}}{{.Receiver}}.{{.Less}}.Before(target.{{.Less}})
+{{- else if eq .Compare "bytes"}}bytes.Compare({{.Receiver}}.{{.Less}}, target.{{.Less}}) < 0
+{{- else}}{{.Receiver}}.{{.Less}} < target.{{.Less}}
+{{- end}}{{end}}`
+
+var containerTemplate = template.Must(template.Must(template.New("container").Parse(lessTemplate)).Parse(`// Code generated by gollections-gen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if eq .Compare "bytes"}}
+	"bytes"
+{{- end}}
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+	"reflect"
+)
+
+// Key returns the {{.Key}} of the {{.Type}}, which signifies its unique identifier.
+func ({{.Receiver}} {{.Type}}) Key() interface{} {
+	return {{.Receiver}}.{{.Key}}
+}
+
+// Less reports whether the {{.Less}} of the {{.Type}} is less than the target's.
+// The target can either be a {{.Type}} or a *{{.Type}}.
+func ({{.Receiver}} {{.Type}}) Less(other containers.Container) bool {
+	switch target := other.(type) {
+	case {{.Type}}:
+		return {{template "less" .}}
+	case *{{.Type}}:
+		return {{template "less" .}}
+	default:
+		panic(fmt.Sprintf("invalid type provided; expected: {{.Package}}.{{.Type}}, received: %T", other))
+	}
+}
+
+// Validate converts an interface type to {{.Type}} container, both {{.Type}} and *{{.Type}} are accepted.
+// Panics if an invalid interface is provided.
+func ({{.Receiver}} {{.Type}}) Validate(value interface{}) containers.Container {
+	converted, err := {{.Receiver}}.TryValidate(value)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+// TryValidate converts an interface type to {{.Type}} container, both {{.Type}} and *{{.Type}} are accepted.
+// Returns a *containers.TypeError if an invalid interface is provided.
+func ({{.Type}}) TryValidate(value interface{}) (containers.Container, error) {
+	switch converted := value.(type) {
+	case {{.Type}}:
+		return converted, nil
+	case *{{.Type}}:
+		return converted, nil
+	default:
+		return nil, &containers.TypeError{Expected: reflect.TypeOf({{.Type}}{}), Actual: reflect.TypeOf(value)}
+	}
+}
+
+// To{{.Type}} converts a Container object to {{.Type}}, dereferencing *{{.Type}} values.
+// Panics if unexpected type is received.
+func To{{.Type}}(value interface{}) {{.Type}} {
+	switch converted := value.(type) {
+	case {{.Type}}:
+		return converted
+	case *{{.Type}}:
+		return *converted
+	default:
+		panic(fmt.Sprintf("invalid type provided; expected: {{.Package}}.{{.Type}}, received: %T", value))
+	}
+}
+
+// To{{.Type}}Slice converts slice of Container objects to slice of {{.Type}}
+func To{{.Type}}Slice(values []containers.Container) []{{.Type}} {
+	var converted []{{.Type}}
+	for _, value := range values {
+		converted = append(converted, To{{.Type}}(value))
+	}
+	return converted
+}
+`))
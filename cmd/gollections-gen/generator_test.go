@@ -0,0 +1,112 @@
+package main
+
+import (
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate_Golden(t *testing.T) {
+	cases := []struct {
+		name   string
+		typ    string
+		key    string
+		less   string
+		golden string
+	}{
+		{name: "key and less", typ: "User", key: "ID", less: "Name", golden: "user_gollections.golden"},
+		{name: "key only", typ: "Group", key: "Name", golden: "group_gollections.golden"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := parseSpec("testdata", tc.typ, tc.key, tc.less)
+			assert.NoError(t, err)
+			actual, err := generate(s)
+			assert.NoError(t, err)
+
+			goldenPath := filepath.Join("testdata", tc.golden)
+			if *update {
+				assert.NoError(t, os.WriteFile(goldenPath, actual, 0644))
+			}
+			expected, err := os.ReadFile(goldenPath)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestParseSpec(t *testing.T) {
+	s, err := parseSpec("testdata", "User", "ID", "")
+	assert.NoError(t, err)
+	assert.Equal(t, spec{Package: "models", Type: "User", Key: "ID", Less: "ID", Compare: compareOperator}, s)
+	assert.Equal(t, "u", s.Receiver())
+
+	_, err = parseSpec("testdata", "Account", "ID", "")
+	assert.Error(t, err)
+	_, err = parseSpec("testdata", "User", "UUID", "")
+	assert.Error(t, err)
+	_, err = parseSpec("testdata", "User", "ID", "Age")
+	assert.Error(t, err)
+	_, err = parseSpec("missing", "User", "ID", "")
+	assert.Error(t, err)
+}
+
+func TestParseSpec_FieldTypes(t *testing.T) {
+	cases := map[string]string{
+		"Name":    compareOperator,
+		"Level":   compareOperator,
+		"Created": compareTime,
+		"Seen":    compareTime,
+		"Avatar":  compareBytes,
+	}
+	for field, compare := range cases {
+		s, err := parseSpec("testdata", "User", "ID", field)
+		assert.NoError(t, err, field)
+		assert.Equal(t, compare, s.Compare, field)
+	}
+
+	for _, field := range []string{"Stamp", "Tags", "Active"} {
+		_, err := parseSpec("testdata", "User", "ID", field)
+		if assert.Error(t, err, field) {
+			assert.Contains(t, err.Error(), "cannot be compared", field)
+		}
+	}
+	_, err := parseSpec("testdata", "User", "Tags", "Name")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "not comparable")
+	}
+}
+
+// TestGenerate_Build verifies that the generated code compiles & passes go vet for every supported field type.
+func TestGenerate_Build(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+	source, err := os.ReadFile(filepath.Join("testdata", "user.go"))
+	assert.NoError(t, err)
+
+	for _, field := range []string{"Name", "Level", "Created", "Seen", "Avatar"} {
+		t.Run(field, func(t *testing.T) {
+			s, err := parseSpec("testdata", "User", "ID", field)
+			assert.NoError(t, err)
+			generated, err := generate(s)
+			assert.NoError(t, err)
+
+			// The package is created inside the module, so that the generated code can import the containers package
+			dir, err := os.MkdirTemp("testdata", "build")
+			assert.NoError(t, err)
+			defer func() { _ = os.RemoveAll(dir) }()
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, "user.go"), source, 0644))
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, "user_gollections.go"), generated, 0644))
+
+			output, err := exec.Command(goTool, "vet", "./"+filepath.ToSlash(dir)).CombinedOutput()
+			assert.NoError(t, err, string(output))
+		})
+	}
+}
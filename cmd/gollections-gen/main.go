@@ -0,0 +1,90 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Command gollections-gen generates containers.Container implementations for user defined struct types, as a
+// compile-time alternative to containers.Of & containers.OfTagged.
+//
+// Usage:
+//
+//	//go:generate gollections-gen -type=User -key=ID -less=Name
+//
+// The above directive writes the Key, Less, Validate & TryValidate methods of User, along with the ToUser &
+// ToUserSlice convertors, in the file user_gollections.go. The generated Validate method accepts both User and *User
+// values. If -less is omitted, the values are ordered by the key field. The key field must be comparable, and the
+// less field must be of a numeric, string, time.Time or []byte type (or a type defined over them).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	typeName  = flag.String("type", "", "name of the struct type; must be set")
+	keyField  = flag.String("key", "", "name of the field returned by Key; must be set")
+	lessField = flag.String("less", "", "name of the field compared by Less; defaults to the key field")
+	output    = flag.String("output", "", "output file name; default <type>_gollections.go")
+)
+
+func usage() {
+	_, _ = fmt.Fprintf(os.Stderr, "Usage of gollections-gen:\n")
+	_, _ = fmt.Fprintf(os.Stderr, "\tgollections-gen -type=T -key=Field [-less=Field] [directory]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gollections-gen: ")
+	flag.Usage = usage
+	flag.Parse()
+	if *typeName == "" || *keyField == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	spec, err := parseSpec(dir, *typeName, *keyField, *lessField)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	outputName := *output
+	if outputName == "" {
+		outputName = filepath.Join(dir, strings.ToLower(*typeName)+"_gollections.go")
+	}
+	if err := os.WriteFile(outputName, src, 0644); err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+}
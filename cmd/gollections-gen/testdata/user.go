@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+//go:generate gollections-gen -type=User -key=ID -less=Name
+
+// User is used for testing the generated container implementation
+type User struct {
+	ID      string
+	Name    string
+	Email   string
+	Created time.Time
+	Avatar  []byte
+	Level   Level
+	Seen    Instant
+	Stamp   Stamp
+	Tags    []string
+	Active  bool
+}
+
+// Level is ordered by its underlying type
+type Level int
+
+// Instant is an alias of time.Time, hence compared using its Before method
+type Instant = time.Time
+
+// Stamp doesn't inherit the Before method of time.Time, hence cannot be ordered
+type Stamp time.Time
+
+// Group is generated with -key only, hence ordered by its key field
+type Group struct {
+	Name string
+}
@@ -0,0 +1,88 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package gollections
+
+// Collection is the interface implemented by every gollections data-structure.
+type Collection interface {
+	// Size returns the number of elements in the collection.
+	Size() int64
+	// Empty reports whether the collection has no elements.
+	Empty() bool
+	// Clear removes all the elements from the collection.
+	Clear()
+	// ToSlice returns the elements of the collection, in the data-structure's iteration order.
+	ToSlice() []interface{}
+}
+
+// Iterable is the interface implemented by every gollections data-structure that can be iterated upon.
+type Iterable interface {
+	// Iter returns an Iterator positioned before the first element of the data-structure.
+	Iter() Iterator
+}
+
+// Iterator is a common iterator over the elements of an Iterable.
+//
+//	for it := list.Iter(); it.HasNext(); {
+//		fmt.Println(it.Next())
+//	}
+type Iterator interface {
+	// HasNext reports whether the iteration has more elements.
+	HasNext() bool
+	// Next advances the iterator and returns the next element. Panics if the iteration has no more elements.
+	Next() interface{}
+	// Value returns the element last returned by Next.
+	Value() interface{}
+}
+
+// sliceIterator is an Iterator over a slice of elements.
+type sliceIterator struct {
+	values []interface{}
+	index  int
+}
+
+func (it *sliceIterator) HasNext() bool {
+	return it.index < len(it.values)
+}
+
+func (it *sliceIterator) Next() interface{} {
+	if !it.HasNext() {
+		panic("iterator has no more elements")
+	}
+	it.index++
+	return it.values[it.index-1]
+}
+
+func (it *sliceIterator) Value() interface{} {
+	if it.index == 0 {
+		return nil
+	}
+	return it.values[it.index-1]
+}
+
+// NewSliceIterator returns an Iterator over the values provided. It is used by the data-structures which iterate over
+// a snapshot of their elements.
+func NewSliceIterator(values []interface{}) Iterator {
+	return &sliceIterator{values: values}
+}
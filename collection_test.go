@@ -0,0 +1,121 @@
+package gollections_test
+
+import (
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/deque"
+	"github.com/soheltarir/gollections/lists"
+	"github.com/soheltarir/gollections/lists/forward"
+	"github.com/soheltarir/gollections/lists/skiplist"
+	"github.com/soheltarir/gollections/lists/unrolled"
+	"github.com/soheltarir/gollections/maps/counter"
+	"github.com/soheltarir/gollections/queue"
+	"github.com/soheltarir/gollections/queue/priority"
+	"github.com/soheltarir/gollections/ringbuffer"
+	"github.com/soheltarir/gollections/stack"
+	"github.com/soheltarir/gollections/trees/binarytrees"
+	"github.com/soheltarir/gollections/trees/bst"
+	"github.com/soheltarir/gollections/trees/heaps"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type iterableCollection interface {
+	gollections.Collection
+	gollections.Iterable
+}
+
+// sum is a helper accepting any gollections data-structure
+func sum(c iterableCollection) int {
+	total := 0
+	for it := c.Iter(); it.HasNext(); {
+		total += it.Next().(int)
+	}
+	return total
+}
+
+func TestCollections(t *testing.T) {
+	bstTree := bst.NewInt()
+	bstTree.InsertMany(2, 1, 3)
+	binaryTree := binarytrees.NewInt()
+	binaryTree.InsertMany(1, 2, 3)
+
+	collections := map[string]iterableCollection{
+		"lists": func() *lists.LinkedList { l := lists.NewInt(); l.Insert(l.Begin(), 1, 2, 3); return l }(),
+		"forward list": func() *forward.List {
+			l := forward.NewInt()
+			l.InsertAfter(l.BeforeBegin(), 1, 2, 3)
+			return l
+		}(),
+		"skip list": func() *skiplist.SkipList {
+			l := skiplist.NewInt()
+			l.Insert(2, nil)
+			l.Insert(1, nil)
+			l.Insert(3, nil)
+			return l
+		}(),
+		"unrolled list": func() *unrolled.List { l := unrolled.NewInt(); l.Insert(l.End(), 1, 2, 3); return l }(),
+		"queue":         queue.NewInt(1, 2, 3),
+		"blocking queue": func() *queue.BlockingQueue {
+			q := queue.NewBlocking(containers.IntContainer(0), 3)
+			for i := 1; i <= 3; i++ {
+				_ = q.Offer(i, 0)
+			}
+			return q
+		}(),
+		"queue with deque": queue.NewWithDeque(containers.IntContainer(0), 1, 2, 3),
+		"delay queue": func() *queue.DelayQueue {
+			q := queue.NewDelay(containers.IntContainer(0))
+			for i := 1; i <= 3; i++ {
+				_ = q.Put(i, time.Duration(i)*time.Hour)
+			}
+			return q
+		}(),
+		"priority queue": func() *priority.Queue {
+			q := priority.NewMax(containers.IntContainer(0))
+			for i := 1; i <= 3; i++ {
+				q.Push(i, i)
+			}
+			return q
+		}(),
+		"deque": deque.NewInt(1, 2, 3),
+		"ring buffer": func() *ringbuffer.RingBuffer {
+			rb := ringbuffer.NewInt(3, ringbuffer.Overwrite)
+			for i := 0; i <= 3; i++ {
+				_ = rb.Push(i)
+			}
+			return rb
+		}(),
+		"stack":            stack.NewInt(1, 2, 3),
+		"stack with deque": stack.NewWithDeque(containers.IntContainer(0), 1, 2, 3),
+		"counter":          counter.NewIntCounter(1, 2, 3, 3).AsCollection(),
+		"min heap":         heaps.NewMinInt(1, 2, 3),
+		"max heap":         heaps.NewMaxInt(1, 2, 3),
+		"binary tree":      binaryTree,
+		"bst":              bstTree,
+	}
+	for name, c := range collections {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, int64(3), c.Size())
+			assert.False(t, c.Empty())
+			assert.ElementsMatch(t, []interface{}{1, 2, 3}, c.ToSlice())
+			assert.Equal(t, 6, sum(c))
+			c.Clear()
+			assert.True(t, c.Empty())
+			assert.Empty(t, c.ToSlice())
+			assert.False(t, c.Iter().HasNext())
+		})
+	}
+}
+
+func TestNewSliceIterator(t *testing.T) {
+	it := gollections.NewSliceIterator([]interface{}{"a", "b"})
+	assert.Nil(t, it.Value())
+	assert.True(t, it.HasNext())
+	assert.Equal(t, "a", it.Next())
+	assert.Equal(t, "a", it.Value())
+	assert.Equal(t, "b", it.Next())
+	assert.False(t, it.HasNext())
+	assert.Panics(t, func() { it.Next() })
+}
@@ -0,0 +1,261 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package containers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// KeyFunc returns the unique identifier of a value wrapped by an Adapter, refer Container.Key.
+type KeyFunc func(value interface{}) interface{}
+
+// LessFunc reports whether the value a is less than the value b, refer Container.Less.
+type LessFunc func(a, b interface{}) bool
+
+// adapterSpec holds the type & the functions shared by all the Adapters built from the same prototype.
+type adapterSpec struct {
+	datatype reflect.Type
+	key      KeyFunc
+	less     LessFunc
+}
+
+// Adapter is a Container built through reflection for any type, which saves writing the Key, Less & Validate
+// methods by hand. Use Of or OfTagged to build the prototype Adapter to be passed to the data-structures.
+//
+// The Validate method of an Adapter accepts both values & pointers of the prototype's type. The KeyFunc & LessFunc
+// always receive the values, i.e., pointers are dereferenced before being passed to them.
+type Adapter struct {
+	value interface{}
+	spec  *adapterSpec
+}
+
+// Value returns the value wrapped by the adapter, as it was provided to Validate.
+func (a Adapter) Value() interface{} {
+	return a.value
+}
+
+// deref returns the value wrapped by the adapter, dereferencing pointers. Nil pointers result in the zero value.
+func (a Adapter) deref() interface{} {
+	rv := reflect.ValueOf(a.value)
+	if rv.Kind() == reflect.Ptr && rv.Type().Elem() == a.spec.datatype {
+		if rv.IsNil() {
+			return reflect.Zero(a.spec.datatype).Interface()
+		}
+		return rv.Elem().Interface()
+	}
+	return a.value
+}
+
+func (a Adapter) Key() interface{} {
+	return a.spec.key(a.deref())
+}
+
+func (a Adapter) Less(value Container) bool {
+	return a.spec.less(a.deref(), value.(Adapter).deref())
+}
+
+func (a Adapter) Validate(x interface{}) Container {
+	converted, err := a.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (a Adapter) TryValidate(x interface{}) (Container, error) {
+	xType := reflect.TypeOf(x)
+	if xType != a.spec.datatype && xType != reflect.PtrTo(a.spec.datatype) {
+		return nil, &TypeError{Expected: a.spec.datatype, Actual: xType}
+	}
+	return Adapter{value: x, spec: a.spec}, nil
+}
+
+// datatypeOf returns the type of the prototype provided, dereferencing pointers.
+func datatypeOf(prototype interface{}) reflect.Type {
+	if prototype == nil {
+		panic("prototype cannot be nil")
+	}
+	datatype := reflect.TypeOf(prototype)
+	if datatype.Kind() == reflect.Ptr {
+		datatype = datatype.Elem()
+	}
+	return datatype
+}
+
+// Of builds a Container for the type of the prototype provided (either a value or a pointer), using key & less as
+// the Key & Less implementations respectively.
+func Of(prototype interface{}, key KeyFunc, less LessFunc) Container {
+	return Adapter{spec: &adapterSpec{datatype: datatypeOf(prototype), key: key, less: less}}
+}
+
+// orderField is a struct field used for ordering the values of an Adapter built by OfTagged.
+type orderField struct {
+	index int
+	desc  bool
+}
+
+// OfTagged builds a Container for the struct type of the prototype provided (either a value or a pointer), using the
+// `gollections` struct tags of its fields:
+//
+//	type User struct {
+//		ID       string    `gollections:"key"`
+//		Priority int       `gollections:"order,desc"`
+//		Created  time.Time `gollections:"order"`
+//	}
+//
+// The field tagged with "key" is returned by the Key method, and hence should be of a comparable type.
+// The fields tagged with "order" are compared in their declaration order by the Less method, optionally reversed by
+// the "desc" option; numeric, string, bool & time.Time fields can be ordered. If no field is tagged with "order", the
+// values are ordered by the key field.
+// Panics if the prototype is not a struct, if there isn't exactly one key field or if a tagged field is unexported or
+// cannot be ordered.
+func OfTagged(prototype interface{}) Container {
+	datatype := datatypeOf(prototype)
+	if datatype.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("expected a struct prototype, received: %s", datatype))
+	}
+	keyIndex := -1
+	var orderFields []orderField
+	for i := 0; i < datatype.NumField(); i++ {
+		field := datatype.Field(i)
+		tag, ok := field.Tag.Lookup("gollections")
+		if !ok {
+			continue
+		}
+		if field.PkgPath != "" {
+			panic(fmt.Sprintf("tagged field %s of %s should be exported", field.Name, datatype))
+		}
+		options := strings.Split(tag, ",")
+		isOrder, isDesc := false, false
+		for _, option := range options {
+			switch strings.TrimSpace(option) {
+			case "key":
+				if keyIndex != -1 {
+					panic(fmt.Sprintf("multiple key fields found in %s", datatype))
+				}
+				if !field.Type.Comparable() {
+					panic(fmt.Sprintf("key field %s of %s is not comparable", field.Name, datatype))
+				}
+				keyIndex = i
+			case "order":
+				isOrder = true
+			case "desc":
+				isDesc = true
+			default:
+				panic(fmt.Sprintf("invalid gollections tag option %q on field %s of %s", option, field.Name, datatype))
+			}
+		}
+		if isOrder {
+			if !isOrderable(field.Type) {
+				panic(fmt.Sprintf("order field %s of %s cannot be ordered", field.Name, datatype))
+			}
+			orderFields = append(orderFields, orderField{index: i, desc: isDesc})
+		}
+	}
+	if keyIndex == -1 {
+		panic(fmt.Sprintf("no key field found in %s", datatype))
+	}
+	if len(orderFields) == 0 {
+		if !isOrderable(datatype.Field(keyIndex).Type) {
+			panic(fmt.Sprintf("key field of %s cannot be ordered, please tag an order field", datatype))
+		}
+		orderFields = append(orderFields, orderField{index: keyIndex})
+	}
+
+	key := func(value interface{}) interface{} {
+		return reflect.ValueOf(value).Field(keyIndex).Interface()
+	}
+	less := func(a, b interface{}) bool {
+		x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+		for _, field := range orderFields {
+			result := compareValues(x.Field(field.index), y.Field(field.index))
+			if field.desc {
+				result = -result
+			}
+			if result != 0 {
+				return result < 0
+			}
+		}
+		return false
+	}
+	return Adapter{spec: &adapterSpec{datatype: datatype, key: key, less: less}}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// isOrderable reports whether the values of the type provided can be compared by compareValues.
+func isOrderable(t reflect.Type) bool {
+	if t == timeType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String, reflect.Bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareValues returns -1, 0 or +1 depending on whether x is less than, equal to or greater than y.
+// Both the values should be of the same orderable type.
+func compareValues(x, y reflect.Value) int {
+	var less, greater bool
+	if x.Type() == timeType {
+		xTime, yTime := x.Interface().(time.Time), y.Interface().(time.Time)
+		less, greater = xTime.Before(yTime), xTime.After(yTime)
+	} else {
+		less, greater = compareKinds(x, y)
+	}
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareKinds reports whether x is less than y & whether x is greater than y, for the basic kinds.
+func compareKinds(x, y reflect.Value) (less, greater bool) {
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		less, greater = x.Int() < y.Int(), x.Int() > y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		less, greater = x.Uint() < y.Uint(), x.Uint() > y.Uint()
+	case reflect.Float32, reflect.Float64:
+		less, greater = x.Float() < y.Float(), x.Float() > y.Float()
+	case reflect.String:
+		less, greater = x.String() < y.String(), x.String() > y.String()
+	case reflect.Bool:
+		less, greater = !x.Bool() && y.Bool(), x.Bool() && !y.Bool()
+	}
+	return less, greater
+}
@@ -0,0 +1,97 @@
+package containers
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type taggedUser struct {
+	ID       string `gollections:"key"`
+	Name     string
+	Priority int       `gollections:"order,desc"`
+	Created  time.Time `gollections:"order"`
+}
+
+func TestOf(t *testing.T) {
+	prototype := Of(TestStruct{},
+		func(value interface{}) interface{} { return value.(TestStruct).ID },
+		func(a, b interface{}) bool { return a.(TestStruct).ID < b.(TestStruct).ID },
+	)
+	a := prototype.Validate(TestStruct{ID: "a"})
+	b := prototype.Validate(&TestStruct{ID: "b"})
+	assert.Equal(t, "a", a.Key())
+	assert.Equal(t, "b", b.Key())
+	assert.True(t, a.Less(b))
+	assert.False(t, b.Less(a))
+	// Pointers are preserved as is
+	assert.Equal(t, &TestStruct{ID: "b"}, CleanBasicType(b))
+	assert.Equal(t, TestStruct{ID: "a"}, CleanBasicType(a))
+	// Nil pointers are treated as zero values
+	assert.Equal(t, "", prototype.Validate((*TestStruct)(nil)).Key())
+
+	assert.Panics(t, func() { prototype.Validate("a") })
+	_, err := TryValidate(prototype, 1)
+	var typeErr *TypeError
+	assert.True(t, errors.As(err, &typeErr))
+	assert.Equal(t, reflect.TypeOf(TestStruct{}), typeErr.Expected)
+	assert.Panics(t, func() { Of(nil, nil, nil) })
+}
+
+func TestOfTagged(t *testing.T) {
+	now := time.Now()
+	prototype := OfTagged(&taggedUser{})
+	a := prototype.Validate(taggedUser{ID: "a", Priority: 1, Created: now})
+	b := prototype.Validate(&taggedUser{ID: "b", Priority: 2, Created: now.Add(time.Second)})
+	c := prototype.Validate(taggedUser{ID: "c", Priority: 2, Created: now})
+	assert.Equal(t, "a", a.Key())
+	// Higher priority comes first
+	assert.True(t, b.Less(a))
+	// Ties are broken by the creation time in ascending order
+	assert.True(t, c.Less(b))
+	assert.False(t, b.Less(c))
+	assert.False(t, c.Less(c))
+}
+
+func TestOfTagged_KeyOrdering(t *testing.T) {
+	type item struct {
+		ID int `gollections:"key"`
+	}
+	prototype := OfTagged(item{})
+	assert.True(t, prototype.Validate(item{ID: 1}).Less(prototype.Validate(item{ID: 2})))
+}
+
+func TestOfTagged_Invalid(t *testing.T) {
+	type noKey struct {
+		Name string `gollections:"order"`
+	}
+	type multipleKeys struct {
+		ID   string `gollections:"key"`
+		UUID string `gollections:"key"`
+	}
+	type unorderable struct {
+		ID   string   `gollections:"key"`
+		Tags []string `gollections:"order"`
+	}
+	type unknownOption struct {
+		ID string `gollections:"primary"`
+	}
+	type unexported struct {
+		id string `gollections:"key"`
+	}
+	assert.Panics(t, func() { OfTagged(1) })
+	assert.Panics(t, func() { OfTagged(noKey{}) })
+	assert.Panics(t, func() { OfTagged(multipleKeys{}) })
+	assert.Panics(t, func() { OfTagged(unorderable{}) })
+	assert.Panics(t, func() { OfTagged(unknownOption{}) })
+	assert.Panics(t, func() { OfTagged(unexported{id: ""}) })
+}
+
+func TestCompareValues(t *testing.T) {
+	assert.Equal(t, -1, compareValues(reflect.ValueOf(uint(1)), reflect.ValueOf(uint(2))))
+	assert.Equal(t, 1, compareValues(reflect.ValueOf(2.5), reflect.ValueOf(1.5)))
+	assert.Equal(t, 0, compareValues(reflect.ValueOf("a"), reflect.ValueOf("a")))
+	assert.Equal(t, -1, compareValues(reflect.ValueOf(false), reflect.ValueOf(true)))
+}
@@ -0,0 +1,114 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package containers
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Comparator reports whether the Container a should be ordered before the Container b.
+// Comparators can be composed using the Reverse, ThenBy, NullsFirst & NullsLast combinators, and can be provided to
+// the data-structures ordering their elements, overriding the Less method of the Containers.
+type Comparator func(a, b Container) bool
+
+// Natural is the Comparator ordering the Containers using their Less method. Being a Comparator, it can be composed
+// directly, e.g. containers.Natural.Reverse().
+var Natural Comparator = func(a, b Container) bool {
+	return a.Less(b)
+}
+
+// ByKey returns a Comparator ordering the Containers by the value returned by keyFunc. The values returned should be
+// of the same numeric, string, bool or time.Time type. Panics if the values cannot be ordered.
+func ByKey(keyFunc func(Container) interface{}) Comparator {
+	return func(a, b Container) bool {
+		x, y := reflect.ValueOf(keyFunc(a)), reflect.ValueOf(keyFunc(b))
+		if x.Type() != y.Type() || !isOrderable(x.Type()) {
+			panic(buildErrorMsg(keyFunc(a), keyFunc(b)))
+		}
+		return compareValues(x, y) < 0
+	}
+}
+
+// Reverse returns a Comparator with the reverse ordering of the Comparator.
+func (c Comparator) Reverse() Comparator {
+	return func(a, b Container) bool {
+		return c(b, a)
+	}
+}
+
+// ThenBy returns a Comparator which orders the Containers using the secondary Comparator, when they are considered
+// equal by the Comparator.
+func (c Comparator) ThenBy(secondary Comparator) Comparator {
+	return func(a, b Container) bool {
+		if c(a, b) {
+			return true
+		}
+		if c(b, a) {
+			return false
+		}
+		return secondary(a, b)
+	}
+}
+
+// NullsFirst returns a nil-safe Comparator which orders nil Containers (including nil pointers) before the others.
+// The non-nil Containers are ordered by the Comparator.
+func (c Comparator) NullsFirst() Comparator {
+	return func(a, b Container) bool {
+		aNil, bNil := isNil(a), isNil(b)
+		if aNil || bNil {
+			return aNil && !bNil
+		}
+		return c(a, b)
+	}
+}
+
+// NullsLast returns a nil-safe Comparator which orders nil Containers (including nil pointers) after the others.
+// The non-nil Containers are ordered by the Comparator.
+func (c Comparator) NullsLast() Comparator {
+	return func(a, b Container) bool {
+		aNil, bNil := isNil(a), isNil(b)
+		if aNil || bNil {
+			return !aNil && bNil
+		}
+		return c(a, b)
+	}
+}
+
+// Sort sorts the Containers in place using the Comparator. The sort is stable.
+func (c Comparator) Sort(values []Container) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return c(values[i], values[j])
+	})
+}
+
+// isNil reports whether the Container is nil, or a nil pointer.
+func isNil(c Container) bool {
+	if c == nil {
+		return true
+	}
+	rv := reflect.ValueOf(c)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
@@ -0,0 +1,84 @@
+package containers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type priorityTask struct {
+	ID       string
+	Priority int
+	Created  int
+}
+
+func (p *priorityTask) Key() interface{} {
+	return p.ID
+}
+
+func (p *priorityTask) Less(item Container) bool {
+	return p.ID < item.(*priorityTask).ID
+}
+
+func (p *priorityTask) Validate(x interface{}) Container {
+	return x.(*priorityTask)
+}
+
+func TestNatural(t *testing.T) {
+	assert.True(t, Natural(IntContainer(1), IntContainer(2)))
+	assert.False(t, Natural(IntContainer(2), IntContainer(1)))
+}
+
+func TestComparator_Reverse(t *testing.T) {
+	reversed := Natural.Reverse()
+	assert.True(t, reversed(IntContainer(2), IntContainer(1)))
+	assert.False(t, reversed(IntContainer(1), IntContainer(2)))
+}
+
+func TestByKey(t *testing.T) {
+	byLength := ByKey(func(c Container) interface{} { return len(c.(StringContainer)) })
+	assert.True(t, byLength(StringContainer("b"), StringContainer("aa")))
+	assert.False(t, byLength(StringContainer("aa"), StringContainer("b")))
+
+	invalid := ByKey(func(c Container) interface{} { return c })
+	assert.Panics(t, func() { invalid(IntContainer(1), StringContainer("a")) })
+}
+
+func TestComparator_ThenBy(t *testing.T) {
+	byPriority := ByKey(func(c Container) interface{} { return c.(*priorityTask).Priority }).Reverse()
+	byCreated := ByKey(func(c Container) interface{} { return c.(*priorityTask).Created })
+	comparator := byPriority.ThenBy(byCreated)
+
+	a := &priorityTask{ID: "a", Priority: 1, Created: 1}
+	b := &priorityTask{ID: "b", Priority: 2, Created: 3}
+	c := &priorityTask{ID: "c", Priority: 2, Created: 2}
+	values := []Container{a, b, c}
+	comparator.Sort(values)
+	assert.Equal(t, []Container{c, b, a}, values)
+}
+
+func TestComparator_NullsFirst(t *testing.T) {
+	comparator := Natural.NullsFirst()
+	var nilTask *priorityTask
+	task := &priorityTask{ID: "a"}
+	assert.True(t, comparator(nil, task))
+	assert.True(t, comparator(nilTask, task))
+	assert.False(t, comparator(task, nilTask))
+	assert.False(t, comparator(nil, nilTask))
+	assert.True(t, comparator(task, &priorityTask{ID: "b"}))
+}
+
+func TestComparator_NullsLast(t *testing.T) {
+	comparator := Natural.NullsLast()
+	var nilTask *priorityTask
+	task := &priorityTask{ID: "a"}
+	assert.False(t, comparator(nilTask, task))
+	assert.True(t, comparator(task, nil))
+	assert.False(t, comparator(nil, nil))
+	assert.False(t, comparator(&priorityTask{ID: "b"}, task))
+}
+
+func TestComparator_Sort(t *testing.T) {
+	values := []Container{IntContainer(3), IntContainer(1), IntContainer(2)}
+	Natural.Reverse().Sort(values)
+	assert.Equal(t, []int{3, 2, 1}, ToIntSlice(values))
+}
@@ -26,6 +26,8 @@ SOFTWARE.
 // Package containers exposes interfaces to build containers, the basic building block for gollections data-structures.
 package containers
 
+import "time"
+
 // ToInt converts a Container object to basic integer type. Panics if unexpected type is received.
 func ToInt(value interface{}) int {
 	converted, ok := value.(IntContainer)
@@ -61,3 +63,291 @@ func ToStringSlice(values []Container) []string {
 	}
 	return converted
 }
+
+// ToFloat64 converts a Container object to basic float64 type. Panics if unexpected type is received.
+func ToFloat64(value interface{}) float64 {
+	converted, ok := value.(Float64Container)
+	if !ok {
+		panic(buildErrorMsg(Float64Container(0), value))
+	}
+	return float64(converted)
+}
+
+// ToFloat64Slice converts slice of Container objects to basic float64 type slice
+func ToFloat64Slice(values []Container) []float64 {
+	var converted []float64
+	for _, value := range values {
+		converted = append(converted, ToFloat64(value))
+	}
+	return converted
+}
+
+// ToInt64 converts a Container object to basic int64 type. Panics if unexpected type is received.
+func ToInt64(value interface{}) int64 {
+	converted, ok := value.(Int64Container)
+	if !ok {
+		panic(buildErrorMsg(Int64Container(0), value))
+	}
+	return int64(converted)
+}
+
+// ToInt64Slice converts slice of Container objects to basic int64 type slice
+func ToInt64Slice(values []Container) []int64 {
+	var converted []int64
+	for _, value := range values {
+		converted = append(converted, ToInt64(value))
+	}
+	return converted
+}
+
+// ToUint64 converts a Container object to basic uint64 type. Panics if unexpected type is received.
+func ToUint64(value interface{}) uint64 {
+	converted, ok := value.(Uint64Container)
+	if !ok {
+		panic(buildErrorMsg(Uint64Container(0), value))
+	}
+	return uint64(converted)
+}
+
+// ToUint64Slice converts slice of Container objects to basic uint64 type slice
+func ToUint64Slice(values []Container) []uint64 {
+	var converted []uint64
+	for _, value := range values {
+		converted = append(converted, ToUint64(value))
+	}
+	return converted
+}
+
+// ToInt8 converts a Container object to basic int8 type. Panics if unexpected type is received.
+func ToInt8(value interface{}) int8 {
+	converted, ok := value.(Int8Container)
+	if !ok {
+		panic(buildErrorMsg(Int8Container(0), value))
+	}
+	return int8(converted)
+}
+
+// ToInt8Slice converts slice of Container objects to basic int8 type slice
+func ToInt8Slice(values []Container) []int8 {
+	var converted []int8
+	for _, value := range values {
+		converted = append(converted, ToInt8(value))
+	}
+	return converted
+}
+
+// ToInt16 converts a Container object to basic int16 type. Panics if unexpected type is received.
+func ToInt16(value interface{}) int16 {
+	converted, ok := value.(Int16Container)
+	if !ok {
+		panic(buildErrorMsg(Int16Container(0), value))
+	}
+	return int16(converted)
+}
+
+// ToInt16Slice converts slice of Container objects to basic int16 type slice
+func ToInt16Slice(values []Container) []int16 {
+	var converted []int16
+	for _, value := range values {
+		converted = append(converted, ToInt16(value))
+	}
+	return converted
+}
+
+// ToInt32 converts a Container object to basic int32 type. Panics if unexpected type is received.
+func ToInt32(value interface{}) int32 {
+	converted, ok := value.(Int32Container)
+	if !ok {
+		panic(buildErrorMsg(Int32Container(0), value))
+	}
+	return int32(converted)
+}
+
+// ToInt32Slice converts slice of Container objects to basic int32 type slice
+func ToInt32Slice(values []Container) []int32 {
+	var converted []int32
+	for _, value := range values {
+		converted = append(converted, ToInt32(value))
+	}
+	return converted
+}
+
+// ToUint converts a Container object to basic uint type. Panics if unexpected type is received.
+func ToUint(value interface{}) uint {
+	converted, ok := value.(UintContainer)
+	if !ok {
+		panic(buildErrorMsg(UintContainer(0), value))
+	}
+	return uint(converted)
+}
+
+// ToUintSlice converts slice of Container objects to basic uint type slice
+func ToUintSlice(values []Container) []uint {
+	var converted []uint
+	for _, value := range values {
+		converted = append(converted, ToUint(value))
+	}
+	return converted
+}
+
+// ToUint8 converts a Container object to basic uint8 type. Panics if unexpected type is received.
+func ToUint8(value interface{}) uint8 {
+	converted, ok := value.(Uint8Container)
+	if !ok {
+		panic(buildErrorMsg(Uint8Container(0), value))
+	}
+	return uint8(converted)
+}
+
+// ToUint8Slice converts slice of Container objects to basic uint8 type slice
+func ToUint8Slice(values []Container) []uint8 {
+	var converted []uint8
+	for _, value := range values {
+		converted = append(converted, ToUint8(value))
+	}
+	return converted
+}
+
+// ToUint16 converts a Container object to basic uint16 type. Panics if unexpected type is received.
+func ToUint16(value interface{}) uint16 {
+	converted, ok := value.(Uint16Container)
+	if !ok {
+		panic(buildErrorMsg(Uint16Container(0), value))
+	}
+	return uint16(converted)
+}
+
+// ToUint16Slice converts slice of Container objects to basic uint16 type slice
+func ToUint16Slice(values []Container) []uint16 {
+	var converted []uint16
+	for _, value := range values {
+		converted = append(converted, ToUint16(value))
+	}
+	return converted
+}
+
+// ToUint32 converts a Container object to basic uint32 type. Panics if unexpected type is received.
+func ToUint32(value interface{}) uint32 {
+	converted, ok := value.(Uint32Container)
+	if !ok {
+		panic(buildErrorMsg(Uint32Container(0), value))
+	}
+	return uint32(converted)
+}
+
+// ToUint32Slice converts slice of Container objects to basic uint32 type slice
+func ToUint32Slice(values []Container) []uint32 {
+	var converted []uint32
+	for _, value := range values {
+		converted = append(converted, ToUint32(value))
+	}
+	return converted
+}
+
+// ToFloat32 converts a Container object to basic float32 type. Panics if unexpected type is received.
+func ToFloat32(value interface{}) float32 {
+	converted, ok := value.(Float32Container)
+	if !ok {
+		panic(buildErrorMsg(Float32Container(0), value))
+	}
+	return float32(converted)
+}
+
+// ToFloat32Slice converts slice of Container objects to basic float32 type slice
+func ToFloat32Slice(values []Container) []float32 {
+	var converted []float32
+	for _, value := range values {
+		converted = append(converted, ToFloat32(value))
+	}
+	return converted
+}
+
+// ToRune converts a Container object to basic rune type. Panics if unexpected type is received.
+func ToRune(value interface{}) rune {
+	converted, ok := value.(RuneContainer)
+	if !ok {
+		panic(buildErrorMsg(RuneContainer(0), value))
+	}
+	return rune(converted)
+}
+
+// ToRuneSlice converts slice of Container objects to basic rune type slice
+func ToRuneSlice(values []Container) []rune {
+	var converted []rune
+	for _, value := range values {
+		converted = append(converted, ToRune(value))
+	}
+	return converted
+}
+
+// ToBool converts a Container object to basic bool type. Panics if unexpected type is received.
+func ToBool(value interface{}) bool {
+	converted, ok := value.(BoolContainer)
+	if !ok {
+		panic(buildErrorMsg(BoolContainer(false), value))
+	}
+	return bool(converted)
+}
+
+// ToBoolSlice converts slice of Container objects to basic bool type slice
+func ToBoolSlice(values []Container) []bool {
+	var converted []bool
+	for _, value := range values {
+		converted = append(converted, ToBool(value))
+	}
+	return converted
+}
+
+// ToDuration converts a Container object to time.Duration type. Panics if unexpected type is received.
+func ToDuration(value interface{}) time.Duration {
+	converted, ok := value.(DurationContainer)
+	if !ok {
+		panic(buildErrorMsg(DurationContainer(0), value))
+	}
+	return time.Duration(converted)
+}
+
+// ToDurationSlice converts slice of Container objects to time.Duration type slice
+func ToDurationSlice(values []Container) []time.Duration {
+	var converted []time.Duration
+	for _, value := range values {
+		converted = append(converted, ToDuration(value))
+	}
+	return converted
+}
+
+// ToTime converts a Container object to time.Time type. Panics if unexpected type is received.
+func ToTime(value interface{}) time.Time {
+	converted, ok := value.(TimeContainer)
+	if !ok {
+		panic(buildErrorMsg(TimeContainer{}, value))
+	}
+	return time.Time(converted)
+}
+
+// ToTimeSlice converts slice of Container objects to time.Time type slice
+func ToTimeSlice(values []Container) []time.Time {
+	var converted []time.Time
+	for _, value := range values {
+		converted = append(converted, ToTime(value))
+	}
+	return converted
+}
+
+// ToBytes converts a Container object to a copy of its byte slice. Panics if unexpected type is received.
+func ToBytes(value interface{}) []byte {
+	converted, ok := value.(BytesContainer)
+	if !ok {
+		panic(buildErrorMsg(BytesContainer{}, value))
+	}
+	return append([]byte(nil), converted...)
+}
+
+// ToBytesSlice converts slice of Container objects to slice of byte slices
+func ToBytesSlice(values []Container) [][]byte {
+	var converted [][]byte
+	for _, value := range values {
+		converted = append(converted, ToBytes(value))
+	}
+	return converted
+}
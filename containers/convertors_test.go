@@ -3,6 +3,7 @@ package containers
 import (
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestToInt(t *testing.T) {
@@ -26,3 +27,100 @@ func TestToStringSlice(t *testing.T) {
 	expected := []string{"hello", "world"}
 	assert.Equal(t, expected, ToStringSlice(arr))
 }
+
+func TestToFloat64(t *testing.T) {
+	assert.Equal(t, 1.5, ToFloat64(Float64Container(1.5)))
+	assert.Equal(t, []float64{1, 2.5}, ToFloat64Slice([]Container{Float64Container(1), Float64Container(2.5)}))
+	assert.Panics(t, func() { ToFloat64(IntContainer(1)) })
+}
+
+func TestToInt64(t *testing.T) {
+	assert.Equal(t, int64(3), ToInt64(Int64Container(3)))
+	assert.Equal(t, []int64{1, 2}, ToInt64Slice([]Container{Int64Container(1), Int64Container(2)}))
+	assert.Panics(t, func() { ToInt64(IntContainer(1)) })
+}
+
+func TestToUint64(t *testing.T) {
+	assert.Equal(t, uint64(3), ToUint64(Uint64Container(3)))
+	assert.Equal(t, []uint64{1}, ToUint64Slice([]Container{Uint64Container(1)}))
+	assert.Panics(t, func() { ToUint64(Int64Container(1)) })
+}
+
+func TestToInt8(t *testing.T) {
+	assert.Equal(t, int8(3), ToInt8(Int8Container(3)))
+	assert.Equal(t, []int8{1, 2}, ToInt8Slice([]Container{Int8Container(1), Int8Container(2)}))
+	assert.Panics(t, func() { ToInt8(IntContainer(1)) })
+}
+
+func TestToInt16(t *testing.T) {
+	assert.Equal(t, int16(3), ToInt16(Int16Container(3)))
+	assert.Equal(t, []int16{1, 2}, ToInt16Slice([]Container{Int16Container(1), Int16Container(2)}))
+	assert.Panics(t, func() { ToInt16(IntContainer(1)) })
+}
+
+func TestToInt32(t *testing.T) {
+	assert.Equal(t, int32(3), ToInt32(Int32Container(3)))
+	assert.Equal(t, []int32{1, 2}, ToInt32Slice([]Container{Int32Container(1), Int32Container(2)}))
+	assert.Panics(t, func() { ToInt32(Int64Container(1)) })
+}
+
+func TestToUint(t *testing.T) {
+	assert.Equal(t, uint(3), ToUint(UintContainer(3)))
+	assert.Equal(t, []uint{1, 2}, ToUintSlice([]Container{UintContainer(1), UintContainer(2)}))
+	assert.Panics(t, func() { ToUint(IntContainer(1)) })
+}
+
+func TestToUint8(t *testing.T) {
+	assert.Equal(t, uint8(3), ToUint8(Uint8Container(3)))
+	assert.Equal(t, []uint8{1, 2}, ToUint8Slice([]Container{Uint8Container(1), Uint8Container(2)}))
+	assert.Panics(t, func() { ToUint8(IntContainer(1)) })
+}
+
+func TestToUint16(t *testing.T) {
+	assert.Equal(t, uint16(3), ToUint16(Uint16Container(3)))
+	assert.Equal(t, []uint16{1, 2}, ToUint16Slice([]Container{Uint16Container(1), Uint16Container(2)}))
+	assert.Panics(t, func() { ToUint16(IntContainer(1)) })
+}
+
+func TestToUint32(t *testing.T) {
+	assert.Equal(t, uint32(3), ToUint32(Uint32Container(3)))
+	assert.Equal(t, []uint32{1, 2}, ToUint32Slice([]Container{Uint32Container(1), Uint32Container(2)}))
+	assert.Panics(t, func() { ToUint32(IntContainer(1)) })
+}
+
+func TestToFloat32(t *testing.T) {
+	assert.Equal(t, float32(3), ToFloat32(Float32Container(3)))
+	assert.Equal(t, []float32{1, 2}, ToFloat32Slice([]Container{Float32Container(1), Float32Container(2)}))
+	assert.Panics(t, func() { ToFloat32(IntContainer(1)) })
+}
+
+func TestToRune(t *testing.T) {
+	assert.Equal(t, 'a', ToRune(RuneContainer('a')))
+	assert.Equal(t, []rune("ab"), ToRuneSlice([]Container{RuneContainer('a'), RuneContainer('b')}))
+	assert.Panics(t, func() { ToRune(StringContainer("a")) })
+}
+
+func TestToBool(t *testing.T) {
+	assert.True(t, ToBool(BoolContainer(true)))
+	assert.Equal(t, []bool{true, false}, ToBoolSlice([]Container{BoolContainer(true), BoolContainer(false)}))
+	assert.Panics(t, func() { ToBool(IntContainer(1)) })
+}
+
+func TestToDuration(t *testing.T) {
+	assert.Equal(t, time.Second, ToDuration(DurationContainer(time.Second)))
+	assert.Equal(t, []time.Duration{time.Minute}, ToDurationSlice([]Container{DurationContainer(time.Minute)}))
+	assert.Panics(t, func() { ToDuration(Int64Container(1)) })
+}
+
+func TestToTime(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, now, ToTime(TimeContainer(now)))
+	assert.Equal(t, []time.Time{now}, ToTimeSlice([]Container{TimeContainer(now)}))
+	assert.Panics(t, func() { ToTime(Int64Container(1)) })
+}
+
+func TestToBytes(t *testing.T) {
+	assert.Equal(t, []byte("a"), ToBytes(BytesContainer("a")))
+	assert.Equal(t, [][]byte{[]byte("a")}, ToBytesSlice([]Container{BytesContainer("a")}))
+	assert.Panics(t, func() { ToBytes(StringContainer("a")) })
+}
@@ -0,0 +1,79 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package containers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNoValueType is returned when values are decoded into a data-structure which wasn't initialised with the
+// Container type of its elements, e.g., a zero value lists.LinkedList instead of the one returned by lists.New.
+var ErrNoValueType = errors.New("value type of the data-structure is not set; initialise it using its New constructor")
+
+// ErrConcurrentModification is reported by fail-fast iterators when the data-structure has been structurally
+// modified (i.e., elements were added, removed or re-ordered) after the iterator was created, other than through the
+// iterator itself.
+var ErrConcurrentModification = errors.New("data-structure was modified after the iterator was created")
+
+// TypeError is returned when a value's type doesn't match the type expected by a Container.
+type TypeError struct {
+	// Expected is the type of the Container validating the value
+	Expected reflect.Type
+	// Actual is the type of the value received
+	Actual reflect.Type
+}
+
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("invalid type provided; expected: %s, received: %s", e.Expected, e.Actual)
+}
+
+// newTypeError builds a TypeError to inform the difference between the expected & actual value/s.
+func newTypeError(expected interface{}, actual interface{}) *TypeError {
+	return &TypeError{Expected: reflect.TypeOf(expected), Actual: reflect.TypeOf(actual)}
+}
+
+// TryValidator is implemented by Containers which can report a validation failure as an error instead of panicking.
+type TryValidator interface {
+	// TryValidate converts an interface type to the corresponding Container. Returns a *TypeError if an invalid
+	// interface is provided.
+	TryValidate(interface{}) (Container, error)
+}
+
+// TryValidate converts the value to the Container type of valueType, returning a *TypeError instead of panicking
+// if the value is invalid. Containers implementing TryValidator are validated through it, otherwise a panic raised
+// by the Container's Validate method is recovered.
+func TryValidate(valueType Container, x interface{}) (result Container, err error) {
+	if validator, ok := valueType.(TryValidator); ok {
+		return validator.TryValidate(x)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, newTypeError(valueType, x)
+		}
+	}()
+	return valueType.Validate(x), nil
+}
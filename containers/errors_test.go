@@ -0,0 +1,34 @@
+package containers
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+func TestTypeError_Error(t *testing.T) {
+	err := newTypeError(IntContainer(0), "a")
+	assert.Equal(t, "invalid type provided; expected: containers.IntContainer, received: string", err.Error())
+	assert.Equal(t, buildErrorMsg(IntContainer(0), "a"), err.Error())
+}
+
+func TestTryValidate(t *testing.T) {
+	value, err := TryValidate(IntContainer(0), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, IntContainer(5), value)
+
+	_, err = TryValidate(StringContainer(""), 5)
+	var typeErr *TypeError
+	assert.True(t, errors.As(err, &typeErr))
+	assert.Equal(t, reflect.TypeOf(StringContainer("")), typeErr.Expected)
+	assert.Equal(t, reflect.TypeOf(5), typeErr.Actual)
+
+	// Containers without TryValidate have their panics recovered
+	value, err = TryValidate(TestStruct{}, TestStruct{ID: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, TestStruct{ID: "1"}, value)
+	_, err = TryValidate(TestStruct{}, 1)
+	assert.True(t, errors.As(err, &typeErr))
+	assert.Equal(t, reflect.TypeOf(TestStruct{}), typeErr.Expected)
+}
@@ -0,0 +1,40 @@
+package containers_test
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/queue"
+)
+
+// Task needs no hand-written Key, Less & Validate methods, the struct tags describe them instead
+type Task struct {
+	ID       string `gollections:"key"`
+	Priority int    `gollections:"order,desc"`
+}
+
+func ExampleOfTagged() {
+	q := queue.New(containers.OfTagged(Task{}))
+	// Both values & pointers are accepted
+	q.Enqueue(Task{ID: "1", Priority: 1})
+	q.Enqueue(&Task{ID: "2", Priority: 5})
+
+	fmt.Println(q.Dequeue())
+	fmt.Println(q.Dequeue())
+
+	// Output:
+	// {1 1}
+	// &{2 5}
+}
+
+func ExampleOf() {
+	prototype := containers.Of(User{},
+		func(value interface{}) interface{} { return value.(User).ID },
+		func(a, b interface{}) bool { return a.(User).Name < b.(User).Name },
+	)
+	user1 := prototype.Validate(User{ID: "1", Name: "B"})
+	user2 := prototype.Validate(&User{ID: "2", Name: "A"})
+	fmt.Println(user1.Key(), user2.Key(), user2.Less(user1))
+
+	// Output:
+	// 1 2 true
+}
@@ -0,0 +1,37 @@
+package containers_test
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/trees/heaps"
+)
+
+// Job is ordered by its Less method using the ID, the heap below overrides the ordering using a comparator
+type Job struct {
+	ID       string `gollections:"key"`
+	Priority int
+	Created  int
+}
+
+func ExampleComparator() {
+	byPriority := containers.ByKey(func(c containers.Container) interface{} {
+		return containers.CleanBasicType(c).(Job).Priority
+	})
+	byCreated := containers.ByKey(func(c containers.Container) interface{} {
+		return containers.CleanBasicType(c).(Job).Created
+	})
+	// Min heap of jobs ordered by (priority desc, created asc)
+	h := heaps.NewMinFunc(containers.OfTagged(Job{}), byPriority.Reverse().ThenBy(byCreated),
+		Job{ID: "a", Priority: 1, Created: 1},
+		Job{ID: "b", Priority: 5, Created: 3},
+		Job{ID: "c", Priority: 5, Created: 2},
+	)
+	for h.Len() > 0 {
+		fmt.Println(containers.CleanBasicType(h.Extract().(containers.Container)).(Job).ID)
+	}
+
+	// Output:
+	// c
+	// b
+	// a
+}
@@ -0,0 +1,106 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package containers
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+// goTypeOf returns the Go type which the Validate method of the Container accepts, i.e., the type which a JSON value
+// should be decoded into before being validated.
+func goTypeOf(valueType Container) reflect.Type {
+	switch c := valueType.(type) {
+	case IntContainer:
+		return reflect.TypeOf(0)
+	case StringContainer:
+		return reflect.TypeOf("")
+	case Float64Container:
+		return reflect.TypeOf(float64(0))
+	case Int64Container:
+		return reflect.TypeOf(int64(0))
+	case Uint64Container:
+		return reflect.TypeOf(uint64(0))
+	case Int8Container:
+		return reflect.TypeOf(int8(0))
+	case Int16Container:
+		return reflect.TypeOf(int16(0))
+	case Int32Container:
+		return reflect.TypeOf(int32(0))
+	case UintContainer:
+		return reflect.TypeOf(uint(0))
+	case Uint8Container:
+		return reflect.TypeOf(uint8(0))
+	case Uint16Container:
+		return reflect.TypeOf(uint16(0))
+	case Uint32Container:
+		return reflect.TypeOf(uint32(0))
+	case Float32Container:
+		return reflect.TypeOf(float32(0))
+	case RuneContainer:
+		return reflect.TypeOf(rune(0))
+	case BoolContainer:
+		return reflect.TypeOf(false)
+	case DurationContainer:
+		return reflect.TypeOf(time.Duration(0))
+	case TimeContainer:
+		return reflect.TypeOf(time.Time{})
+	case BytesContainer:
+		return reflect.TypeOf([]byte{})
+	case Adapter:
+		return c.spec.datatype
+	default:
+		return reflect.TypeOf(valueType)
+	}
+}
+
+// UnmarshalJSON decodes the JSON encoded value into a Container of the valueType's type. The value is decoded into
+// the Go type accepted by the valueType's Validate method, e.g., int for IntContainer, or the struct type itself for
+// user defined Containers.
+func UnmarshalJSON(valueType Container, data []byte) (Container, error) {
+	decoded := reflect.New(goTypeOf(valueType))
+	if err := json.Unmarshal(data, decoded.Interface()); err != nil {
+		return nil, err
+	}
+	return TryValidate(valueType, decoded.Elem().Interface())
+}
+
+// UnmarshalJSONArray decodes the JSON encoded array into a slice of Containers of the valueType's type.
+func UnmarshalJSONArray(valueType Container, data []byte) ([]Container, error) {
+	var rawValues []json.RawMessage
+	if err := json.Unmarshal(data, &rawValues); err != nil {
+		return nil, err
+	}
+	values := make([]Container, 0, len(rawValues))
+	for _, raw := range rawValues {
+		value, err := UnmarshalJSON(valueType, raw)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,78 @@
+package containers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalJSON(t *testing.T) {
+	now := time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		valueType Container
+		data      string
+		expected  Container
+	}{
+		{IntContainer(0), `42`, IntContainer(42)},
+		{StringContainer(""), `"gollections"`, StringContainer("gollections")},
+		{Float64Container(0), `1.5`, Float64Container(1.5)},
+		{Int64Container(0), `-7`, Int64Container(-7)},
+		{Uint64Container(0), `7`, Uint64Container(7)},
+		{RuneContainer(0), `97`, RuneContainer('a')},
+		{Int8Container(0), `-8`, Int8Container(-8)},
+		{Int16Container(0), `-16`, Int16Container(-16)},
+		{Int32Container(0), `-32`, Int32Container(-32)},
+		{UintContainer(0), `1`, UintContainer(1)},
+		{Uint8Container(0), `8`, Uint8Container(8)},
+		{Uint16Container(0), `16`, Uint16Container(16)},
+		{Uint32Container(0), `32`, Uint32Container(32)},
+		{Float32Container(0), `0.5`, Float32Container(0.5)},
+		{BoolContainer(false), `true`, BoolContainer(true)},
+		{DurationContainer(0), `1000000000`, DurationContainer(time.Second)},
+		{TimeContainer{}, `"2021-06-01T10:30:00Z"`, TimeContainer(now)},
+		{BytesContainer{}, `"Z28="`, BytesContainer("go")},
+		{TestStruct{}, `{"ID": "1"}`, TestStruct{ID: "1"}},
+	}
+	for _, testCase := range testCases {
+		actual, err := UnmarshalJSON(testCase.valueType, []byte(testCase.data))
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, actual)
+	}
+}
+
+func TestUnmarshalJSON_Adapter(t *testing.T) {
+	prototype := OfTagged(&taggedUser{})
+	actual, err := UnmarshalJSON(prototype, []byte(`{"ID": "a", "Priority": 2}`))
+	assert.NoError(t, err)
+	assert.Equal(t, taggedUser{ID: "a", Priority: 2}, CleanBasicType(actual))
+}
+
+func TestUnmarshalJSON_Invalid(t *testing.T) {
+	_, err := UnmarshalJSON(IntContainer(0), []byte(`"one"`))
+	assert.Error(t, err)
+	_, err = UnmarshalJSON(IntContainer(0), []byte(`1.5`))
+	assert.Error(t, err)
+}
+
+func TestUnmarshalJSONArray(t *testing.T) {
+	actual, err := UnmarshalJSONArray(StringContainer(""), []byte(`["a", "b"]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []Container{StringContainer("a"), StringContainer("b")}, actual)
+
+	actual, err = UnmarshalJSONArray(StringContainer(""), []byte(`[]`))
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+
+	_, err = UnmarshalJSONArray(StringContainer(""), []byte(`{"a": 1}`))
+	assert.Error(t, err)
+	_, err = UnmarshalJSONArray(StringContainer(""), []byte(`["a", 1]`))
+	assert.Error(t, err)
+}
+
+func TestGoTypeOf(t *testing.T) {
+	assert.Equal(t, reflect.TypeOf(0), goTypeOf(IntContainer(0)))
+	assert.Equal(t, reflect.TypeOf(taggedUser{}), goTypeOf(OfTagged(&taggedUser{})))
+	assert.Equal(t, reflect.TypeOf(TestStruct{}), goTypeOf(TestStruct{}))
+	assert.Equal(t, reflect.TypeOf(&TestStruct{}), goTypeOf(&TestStruct{}))
+}
@@ -25,13 +25,14 @@ SOFTWARE.
 package containers
 
 import (
-	"fmt"
-	"reflect"
+	"bytes"
+	"time"
 )
 
 // Container is base interface that most of the data structures use.
 // Any struct/type should implement the methods to be considered as a `Container`.
-// Refer IntContainer for implementation reference
+// Refer IntContainer for implementation reference.
+// Containers can additionally implement TryValidator to report validation failures as errors instead of panics.
 type Container interface {
 	// Key signifies a unique identifier for the struct/type. This function should return the datatype which
 	// implement equality (=) operator, i.e., the datatype of the Key should be one of Go's basic types (https://tour.golang.org/basics/11)
@@ -54,12 +55,20 @@ func (c IntContainer) Less(value Container) bool {
 	return int(c) < int(y)
 }
 
-func (IntContainer) Validate(x interface{}) Container {
+func (c IntContainer) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (IntContainer) TryValidate(x interface{}) (Container, error) {
 	converted, ok := x.(int)
 	if !ok {
-		panic(buildErrorMsg(IntContainer(0), x))
+		return nil, newTypeError(IntContainer(0), x)
 	}
-	return IntContainer(converted)
+	return IntContainer(converted), nil
 }
 
 // StringContainer is a Container encapsulation over the basic string type
@@ -75,28 +84,478 @@ func (c StringContainer) Less(value Container) bool {
 	return x < y
 }
 
-func (StringContainer) Validate(x interface{}) Container {
+func (c StringContainer) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (StringContainer) TryValidate(x interface{}) (Container, error) {
 	converted, ok := x.(string)
 	if !ok {
-		panic(buildErrorMsg(StringContainer(""), x))
+		return nil, newTypeError(StringContainer(""), x)
+	}
+	return StringContainer(converted), nil
+}
+
+// Float64Container is a Container encapsulation over the basic float64 type
+type Float64Container float64
+
+func (c Float64Container) Key() interface{} {
+	return float64(c)
+}
+
+func (c Float64Container) Less(value Container) bool {
+	return float64(c) < float64(value.(Float64Container))
+}
+
+func (c Float64Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Float64Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(float64)
+	if !ok {
+		return nil, newTypeError(Float64Container(0), x)
+	}
+	return Float64Container(converted), nil
+}
+
+// Int64Container is a Container encapsulation over the basic int64 type
+type Int64Container int64
+
+func (c Int64Container) Key() interface{} {
+	return int64(c)
+}
+
+func (c Int64Container) Less(value Container) bool {
+	return int64(c) < int64(value.(Int64Container))
+}
+
+func (c Int64Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Int64Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(int64)
+	if !ok {
+		return nil, newTypeError(Int64Container(0), x)
+	}
+	return Int64Container(converted), nil
+}
+
+// Uint64Container is a Container encapsulation over the basic uint64 type
+type Uint64Container uint64
+
+func (c Uint64Container) Key() interface{} {
+	return uint64(c)
+}
+
+func (c Uint64Container) Less(value Container) bool {
+	return uint64(c) < uint64(value.(Uint64Container))
+}
+
+func (c Uint64Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Uint64Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(uint64)
+	if !ok {
+		return nil, newTypeError(Uint64Container(0), x)
+	}
+	return Uint64Container(converted), nil
+}
+
+// Int8Container is a Container encapsulation over the basic int8 type
+type Int8Container int8
+
+func (c Int8Container) Key() interface{} {
+	return int8(c)
+}
+
+func (c Int8Container) Less(value Container) bool {
+	return int8(c) < int8(value.(Int8Container))
+}
+
+func (c Int8Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Int8Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(int8)
+	if !ok {
+		return nil, newTypeError(Int8Container(0), x)
+	}
+	return Int8Container(converted), nil
+}
+
+// Int16Container is a Container encapsulation over the basic int16 type
+type Int16Container int16
+
+func (c Int16Container) Key() interface{} {
+	return int16(c)
+}
+
+func (c Int16Container) Less(value Container) bool {
+	return int16(c) < int16(value.(Int16Container))
+}
+
+func (c Int16Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Int16Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(int16)
+	if !ok {
+		return nil, newTypeError(Int16Container(0), x)
+	}
+	return Int16Container(converted), nil
+}
+
+// Int32Container is a Container encapsulation over the basic int32 type
+type Int32Container int32
+
+func (c Int32Container) Key() interface{} {
+	return int32(c)
+}
+
+func (c Int32Container) Less(value Container) bool {
+	return int32(c) < int32(value.(Int32Container))
+}
+
+func (c Int32Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Int32Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(int32)
+	if !ok {
+		return nil, newTypeError(Int32Container(0), x)
+	}
+	return Int32Container(converted), nil
+}
+
+// UintContainer is a Container encapsulation over the basic uint type
+type UintContainer uint
+
+func (c UintContainer) Key() interface{} {
+	return uint(c)
+}
+
+func (c UintContainer) Less(value Container) bool {
+	return uint(c) < uint(value.(UintContainer))
+}
+
+func (c UintContainer) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (UintContainer) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(uint)
+	if !ok {
+		return nil, newTypeError(UintContainer(0), x)
+	}
+	return UintContainer(converted), nil
+}
+
+// Uint8Container is a Container encapsulation over the basic uint8 type
+type Uint8Container uint8
+
+func (c Uint8Container) Key() interface{} {
+	return uint8(c)
+}
+
+func (c Uint8Container) Less(value Container) bool {
+	return uint8(c) < uint8(value.(Uint8Container))
+}
+
+func (c Uint8Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Uint8Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(uint8)
+	if !ok {
+		return nil, newTypeError(Uint8Container(0), x)
+	}
+	return Uint8Container(converted), nil
+}
+
+// Uint16Container is a Container encapsulation over the basic uint16 type
+type Uint16Container uint16
+
+func (c Uint16Container) Key() interface{} {
+	return uint16(c)
+}
+
+func (c Uint16Container) Less(value Container) bool {
+	return uint16(c) < uint16(value.(Uint16Container))
+}
+
+func (c Uint16Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Uint16Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(uint16)
+	if !ok {
+		return nil, newTypeError(Uint16Container(0), x)
+	}
+	return Uint16Container(converted), nil
+}
+
+// Uint32Container is a Container encapsulation over the basic uint32 type
+type Uint32Container uint32
+
+func (c Uint32Container) Key() interface{} {
+	return uint32(c)
+}
+
+func (c Uint32Container) Less(value Container) bool {
+	return uint32(c) < uint32(value.(Uint32Container))
+}
+
+func (c Uint32Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Uint32Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(uint32)
+	if !ok {
+		return nil, newTypeError(Uint32Container(0), x)
+	}
+	return Uint32Container(converted), nil
+}
+
+// Float32Container is a Container encapsulation over the basic float32 type
+type Float32Container float32
+
+func (c Float32Container) Key() interface{} {
+	return float32(c)
+}
+
+func (c Float32Container) Less(value Container) bool {
+	return float32(c) < float32(value.(Float32Container))
+}
+
+func (c Float32Container) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (Float32Container) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(float32)
+	if !ok {
+		return nil, newTypeError(Float32Container(0), x)
+	}
+	return Float32Container(converted), nil
+}
+
+// RuneContainer is a Container encapsulation over the basic rune type
+type RuneContainer rune
+
+func (c RuneContainer) Key() interface{} {
+	return rune(c)
+}
+
+func (c RuneContainer) Less(value Container) bool {
+	return rune(c) < rune(value.(RuneContainer))
+}
+
+func (c RuneContainer) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (RuneContainer) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(rune)
+	if !ok {
+		return nil, newTypeError(RuneContainer(0), x)
+	}
+	return RuneContainer(converted), nil
+}
+
+// BoolContainer is a Container encapsulation over the basic bool type. false is considered less than true
+type BoolContainer bool
+
+func (c BoolContainer) Key() interface{} {
+	return bool(c)
+}
+
+func (c BoolContainer) Less(value Container) bool {
+	return !bool(c) && bool(value.(BoolContainer))
+}
+
+func (c BoolContainer) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (BoolContainer) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(bool)
+	if !ok {
+		return nil, newTypeError(BoolContainer(false), x)
+	}
+	return BoolContainer(converted), nil
+}
+
+// DurationContainer is a Container encapsulation over the time.Duration type
+type DurationContainer time.Duration
+
+func (c DurationContainer) Key() interface{} {
+	return time.Duration(c)
+}
+
+func (c DurationContainer) Less(value Container) bool {
+	return time.Duration(c) < time.Duration(value.(DurationContainer))
+}
+
+func (c DurationContainer) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (DurationContainer) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(time.Duration)
+	if !ok {
+		return nil, newTypeError(DurationContainer(0), x)
+	}
+	return DurationContainer(converted), nil
+}
+
+// TimeContainer is a Container encapsulation over the time.Time type.
+// The Key of the container is the Unix time in nanoseconds, hence times representing the same instant in different
+// locations are considered equal.
+type TimeContainer time.Time
+
+func (c TimeContainer) Key() interface{} {
+	return time.Time(c).UnixNano()
+}
+
+func (c TimeContainer) Less(value Container) bool {
+	return time.Time(c).Before(time.Time(value.(TimeContainer)))
+}
+
+func (c TimeContainer) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (TimeContainer) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.(time.Time)
+	if !ok {
+		return nil, newTypeError(TimeContainer{}, x)
+	}
+	return TimeContainer(converted), nil
+}
+
+// BytesContainer is a Container encapsulation over the []byte type.
+// Since slices don't implement the equality operator, the Key of the container is the string conversion of the bytes.
+// The bytes are copied on validation, and CleanBasicType returns a copy of them, hence modifying a buffer after
+// inserting it, or after retrieving it, doesn't corrupt the data-structures ordering their elements.
+type BytesContainer []byte
+
+func (c BytesContainer) Key() interface{} {
+	return string(c)
+}
+
+func (c BytesContainer) Less(value Container) bool {
+	return bytes.Compare(c, value.(BytesContainer)) < 0
+}
+
+func (c BytesContainer) Validate(x interface{}) Container {
+	converted, err := c.TryValidate(x)
+	if err != nil {
+		panic(err.Error())
+	}
+	return converted
+}
+
+func (BytesContainer) TryValidate(x interface{}) (Container, error) {
+	converted, ok := x.([]byte)
+	if !ok {
+		return nil, newTypeError(BytesContainer{}, x)
 	}
-	return StringContainer(converted)
+	return BytesContainer(append([]byte(nil), converted...)), nil
 }
 
 // buildErrorMsg builds an error string to inform the difference between the expected & actual value/s.
 func buildErrorMsg(expected interface{}, actual interface{}) string {
-	return fmt.Sprintf("invalid type provided; expected: %s, received: %s",
-		reflect.TypeOf(expected), reflect.TypeOf(actual))
+	return newTypeError(expected, actual).Error()
 }
 
 // CleanBasicType checks whether the container provided is one of Go's basic types and converts them accordingly
 // to avoid manual conversion from containers (implemented by the package).
 func CleanBasicType(container Container) interface{} {
-	switch container.(type) {
-	case IntContainer:
-		return container.Key()
-	case StringContainer:
-		return container.Key()
+	switch c := container.(type) {
+	case IntContainer, StringContainer, Float64Container, Int64Container, Uint64Container, Int8Container,
+		Int16Container, Int32Container, UintContainer, Uint8Container, Uint16Container, Uint32Container,
+		Float32Container, RuneContainer, BoolContainer, DurationContainer:
+		return c.Key()
+	case TimeContainer:
+		return time.Time(c)
+	case BytesContainer:
+		return append([]byte(nil), c...)
+	case Adapter:
+		return c.Value()
 	default:
 		return container
 	}
@@ -3,6 +3,7 @@ package containers
 import (
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 /** Test Struct container */
@@ -69,3 +70,167 @@ func TestCleanBasicType(t *testing.T) {
 	item := TestStruct{ID: "1"}
 	assert.Equal(t, item, CleanBasicType(item))
 }
+
+func TestFloat64Container(t *testing.T) {
+	a := Float64Container(1.5)
+	assert.Equal(t, 1.5, a.Key())
+	assert.True(t, a.Less(Float64Container(2)))
+	assert.Equal(t, Float64Container(2.5), a.Validate(2.5))
+	assert.Panics(t, func() { a.Validate(1) })
+}
+
+func TestInt64Container(t *testing.T) {
+	a := Int64Container(1)
+	assert.Equal(t, int64(1), a.Key())
+	assert.True(t, a.Less(Int64Container(2)))
+	assert.Equal(t, Int64Container(5), a.Validate(int64(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestUint64Container(t *testing.T) {
+	a := Uint64Container(1)
+	assert.Equal(t, uint64(1), a.Key())
+	assert.False(t, a.Less(Uint64Container(0)))
+	assert.Equal(t, Uint64Container(5), a.Validate(uint64(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestInt8Container(t *testing.T) {
+	a := Int8Container(1)
+	assert.Equal(t, int8(1), a.Key())
+	assert.True(t, a.Less(Int8Container(2)))
+	assert.Equal(t, Int8Container(5), a.Validate(int8(5)))
+	assert.Equal(t, int8(5), CleanBasicType(Int8Container(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestInt16Container(t *testing.T) {
+	a := Int16Container(1)
+	assert.Equal(t, int16(1), a.Key())
+	assert.True(t, a.Less(Int16Container(2)))
+	assert.Equal(t, Int16Container(5), a.Validate(int16(5)))
+	assert.Equal(t, int16(5), CleanBasicType(Int16Container(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestInt32Container(t *testing.T) {
+	a := Int32Container(1)
+	assert.Equal(t, int32(1), a.Key())
+	assert.True(t, a.Less(Int32Container(2)))
+	assert.Equal(t, Int32Container(5), a.Validate(int32(5)))
+	assert.Equal(t, int32(5), CleanBasicType(Int32Container(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestUintContainer(t *testing.T) {
+	a := UintContainer(1)
+	assert.Equal(t, uint(1), a.Key())
+	assert.True(t, a.Less(UintContainer(2)))
+	assert.Equal(t, UintContainer(5), a.Validate(uint(5)))
+	assert.Equal(t, uint(5), CleanBasicType(UintContainer(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestUint8Container(t *testing.T) {
+	a := Uint8Container(1)
+	assert.Equal(t, uint8(1), a.Key())
+	assert.True(t, a.Less(Uint8Container(2)))
+	assert.Equal(t, Uint8Container(5), a.Validate(uint8(5)))
+	assert.Equal(t, uint8(5), CleanBasicType(Uint8Container(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestUint16Container(t *testing.T) {
+	a := Uint16Container(1)
+	assert.Equal(t, uint16(1), a.Key())
+	assert.True(t, a.Less(Uint16Container(2)))
+	assert.Equal(t, Uint16Container(5), a.Validate(uint16(5)))
+	assert.Equal(t, uint16(5), CleanBasicType(Uint16Container(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestUint32Container(t *testing.T) {
+	a := Uint32Container(1)
+	assert.Equal(t, uint32(1), a.Key())
+	assert.True(t, a.Less(Uint32Container(2)))
+	assert.Equal(t, Uint32Container(5), a.Validate(uint32(5)))
+	assert.Equal(t, uint32(5), CleanBasicType(Uint32Container(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestFloat32Container(t *testing.T) {
+	a := Float32Container(1)
+	assert.Equal(t, float32(1), a.Key())
+	assert.True(t, a.Less(Float32Container(2)))
+	assert.Equal(t, Float32Container(5), a.Validate(float32(5)))
+	assert.Equal(t, float32(5), CleanBasicType(Float32Container(5)))
+	assert.Panics(t, func() { a.Validate(5) })
+}
+
+func TestRuneContainer(t *testing.T) {
+	a := RuneContainer('a')
+	assert.Equal(t, 'a', a.Key())
+	assert.True(t, a.Less(RuneContainer('b')))
+	assert.Equal(t, RuneContainer('z'), a.Validate('z'))
+	assert.Panics(t, func() { a.Validate("z") })
+}
+
+func TestBoolContainer(t *testing.T) {
+	a := BoolContainer(false)
+	assert.Equal(t, false, a.Key())
+	assert.True(t, a.Less(BoolContainer(true)))
+	assert.False(t, BoolContainer(true).Less(a))
+	assert.Equal(t, BoolContainer(true), a.Validate(true))
+	assert.Panics(t, func() { a.Validate(1) })
+}
+
+func TestDurationContainer(t *testing.T) {
+	a := DurationContainer(time.Second)
+	assert.Equal(t, time.Second, a.Key())
+	assert.True(t, a.Less(DurationContainer(time.Minute)))
+	assert.Equal(t, DurationContainer(time.Hour), a.Validate(time.Hour))
+	assert.Panics(t, func() { a.Validate(int64(1)) })
+}
+
+func TestTimeContainer(t *testing.T) {
+	now := time.Now()
+	a := TimeContainer(now)
+	assert.Equal(t, now.UnixNano(), a.Key())
+	// The same instant in a different location has the same key
+	assert.Equal(t, a.Key(), TimeContainer(now.UTC()).Key())
+	assert.True(t, a.Less(TimeContainer(now.Add(time.Second))))
+	assert.Equal(t, TimeContainer(now), a.Validate(now))
+	assert.Panics(t, func() { a.Validate(now.Unix()) })
+}
+
+func TestBytesContainer(t *testing.T) {
+	a := BytesContainer("abc")
+	assert.Equal(t, "abc", a.Key())
+	assert.True(t, a.Less(BytesContainer("abd")))
+	assert.Equal(t, BytesContainer("xyz"), a.Validate([]byte("xyz")))
+	assert.Panics(t, func() { a.Validate("xyz") })
+
+	// The container doesn't alias the buffers provided to or returned by it
+	buf := []byte("xyz")
+	validated := a.Validate(buf)
+	buf[0] = 'a'
+	assert.Equal(t, BytesContainer("xyz"), validated)
+	cleaned := CleanBasicType(validated).([]byte)
+	cleaned[0] = 'a'
+	assert.Equal(t, BytesContainer("xyz"), validated)
+	converted := ToBytes(validated)
+	converted[0] = 'a'
+	assert.Equal(t, BytesContainer("xyz"), validated)
+	ToBytesSlice([]Container{validated})[0][0] = 'a'
+	assert.Equal(t, BytesContainer("xyz"), validated)
+	assert.Equal(t, "xyz", validated.Key())
+}
+
+func TestCleanBasicType_Extended(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, 1.5, CleanBasicType(Float64Container(1.5)))
+	assert.Equal(t, true, CleanBasicType(BoolContainer(true)))
+	assert.Equal(t, time.Second, CleanBasicType(DurationContainer(time.Second)))
+	assert.Equal(t, now, CleanBasicType(TimeContainer(now)))
+	assert.Equal(t, []byte("a"), CleanBasicType(BytesContainer("a")))
+}
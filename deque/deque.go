@@ -0,0 +1,510 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package deque exposes a double-ended queue data-structure, https://en.wikipedia.org/wiki/Double-ended_queue.
+//
+// The elements of a deque are stored in a growable ring buffer, hence pushing & popping at either end, as well as
+// accessing an element by its index, take constant amortized time. Compared to the lists package, which allocates a
+// node per element, the elements are stored contiguously and the buffer is reused as the deque grows and shrinks.
+package deque
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"iter"
+	"strings"
+	"sync"
+)
+
+// minCapacity is the initial capacity of the ring buffer; the buffer never shrinks below it
+const minCapacity = 8
+
+// Deque is a sequence container which can be expanded or contracted at both ends, and whose elements can be accessed
+// by their index in constant time.
+//
+// All the operations on the deque are thread-safe.
+type Deque struct {
+	// buffer is the ring buffer storing the elements, its length is always zero or a power of two
+	buffer    []containers.Container
+	head      int
+	size      int
+	valueType containers.Container
+	mu        sync.RWMutex
+}
+
+/** Element Access **/
+
+// Front returns the value of the first element of the deque, or nil if the deque is empty.
+func (d *Deque) Front() interface{} {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.size == 0 {
+		return nil
+	}
+	return containers.CleanBasicType(d.buffer[d.head])
+}
+
+// Back returns the value of the last element of the deque, or nil if the deque is empty.
+func (d *Deque) Back() interface{} {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.size == 0 {
+		return nil
+	}
+	return containers.CleanBasicType(d.buffer[d.index(d.size-1)])
+}
+
+// At returns the value of the element at the given index, counted from the front of the deque.
+// Panics if the index is out of range.
+func (d *Deque) At(index int64) interface{} {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	d.checkIndex(index)
+	return containers.CleanBasicType(d.buffer[d.index(int(index))])
+}
+
+// All returns an iterator over the index-value pairs of the deque from the front to the back.
+// The deque must not be modified within the loop.
+func (d *Deque) All() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		d.mu.RLock()
+		defer d.mu.RUnlock()
+
+		for i := 0; i < d.size; i++ {
+			if !yield(int64(i), containers.CleanBasicType(d.buffer[d.index(i)])) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index-value pairs of the deque from the back to the front.
+// The deque must not be modified within the loop.
+func (d *Deque) Backward() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		d.mu.RLock()
+		defer d.mu.RUnlock()
+
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(int64(i), containers.CleanBasicType(d.buffer[d.index(i)])) {
+				return
+			}
+		}
+	}
+}
+
+/** Modifiers **/
+
+// PushFront inserts a new element at the beginning of the deque, before its current first element.
+// Panics if an invalid type is provided.
+func (d *Deque) PushFront(value interface{}) {
+	element := d.valueType.Validate(value)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.pushFront(element)
+}
+
+// TryPushFront is similar to PushFront, but returns a *containers.TypeError instead of panicking if an invalid type
+// is provided.
+func (d *Deque) TryPushFront(value interface{}) error {
+	element, err := containers.TryValidate(d.valueType, value)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.pushFront(element)
+	return nil
+}
+
+func (d *Deque) pushFront(element containers.Container) {
+	d.grow()
+	d.head = (d.head - 1) & (len(d.buffer) - 1)
+	d.buffer[d.head] = element
+	d.size++
+}
+
+// PushBack inserts a new element at the end of the deque, after its current last element.
+// Panics if an invalid type is provided.
+func (d *Deque) PushBack(value interface{}) {
+	element := d.valueType.Validate(value)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.pushBack(element)
+}
+
+// TryPushBack is similar to PushBack, but returns a *containers.TypeError instead of panicking if an invalid type is
+// provided.
+func (d *Deque) TryPushBack(value interface{}) error {
+	element, err := containers.TryValidate(d.valueType, value)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.pushBack(element)
+	return nil
+}
+
+func (d *Deque) pushBack(element containers.Container) {
+	d.grow()
+	d.buffer[d.index(d.size)] = element
+	d.size++
+}
+
+// PopFront removes the first element of the deque and returns its value, or nil if the deque is empty.
+func (d *Deque) PopFront() interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.size == 0 {
+		return nil
+	}
+	element := d.buffer[d.head]
+	d.buffer[d.head] = nil
+	d.head = d.index(1)
+	d.size--
+	d.shrink()
+	return containers.CleanBasicType(element)
+}
+
+// PopBack removes the last element of the deque and returns its value, or nil if the deque is empty.
+func (d *Deque) PopBack() interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.size == 0 {
+		return nil
+	}
+	tail := d.index(d.size - 1)
+	element := d.buffer[tail]
+	d.buffer[tail] = nil
+	d.size--
+	d.shrink()
+	return containers.CleanBasicType(element)
+}
+
+// Set replaces the value of the element at the given index, counted from the front of the deque.
+// Panics if the index is out of range or an invalid type is provided.
+func (d *Deque) Set(index int64, value interface{}) {
+	element := d.valueType.Validate(value)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.checkIndex(index)
+	d.buffer[d.index(int(index))] = element
+}
+
+// TrySet is similar to Set, but returns a *containers.TypeError instead of panicking if an invalid type is provided.
+func (d *Deque) TrySet(index int64, value interface{}) error {
+	element, err := containers.TryValidate(d.valueType, value)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.checkIndex(index)
+	d.buffer[d.index(int(index))] = element
+	return nil
+}
+
+// Rotate rotates the elements of the deque n steps to the back, i.e., the last n elements are moved to the front;
+// if n is negative, the first -n elements are moved to the back instead. Rotating a full ring buffer takes constant
+// time, otherwise the elements are moved one at a time in whichever direction needs fewer moves, i.e., at most half
+// of the deque's size.
+func (d *Deque) Rotate(n int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.size < 2 {
+		return
+	}
+	steps := int(n % int64(d.size))
+	if steps < 0 {
+		steps += d.size
+	}
+	if steps == 0 {
+		return
+	}
+
+	mask := len(d.buffer) - 1
+	if d.size == len(d.buffer) {
+		d.head = (d.head - steps) & mask
+		return
+	}
+	if steps <= d.size/2 {
+		// Move the last elements to the front, one at a time
+		for ; steps > 0; steps-- {
+			tail := d.index(d.size - 1)
+			d.head = (d.head - 1) & mask
+			d.buffer[d.head], d.buffer[tail] = d.buffer[tail], nil
+		}
+		return
+	}
+	// Move the first elements to the back, one at a time
+	for steps = d.size - steps; steps > 0; steps-- {
+		tail := d.index(d.size)
+		d.buffer[tail], d.buffer[d.head] = d.buffer[d.head], nil
+		d.head = (d.head + 1) & mask
+	}
+}
+
+// Clear deletes all the elements of the deque, effectively reducing its size to 0 and releasing its buffer.
+func (d *Deque) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.buffer = nil
+	d.head = 0
+	d.size = 0
+}
+
+/** Capacity **/
+
+// Size returns the number of elements in the deque.
+func (d *Deque) Size() int64 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return int64(d.size)
+}
+
+// Empty returns whether the deque is empty, i.e. whether its size is zero.
+func (d *Deque) Empty() bool {
+	return d.Size() == 0
+}
+
+// Cap returns the number of elements the deque can hold before its buffer has to grow.
+func (d *Deque) Cap() int64 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return int64(len(d.buffer))
+}
+
+// ToSlice returns the values of the deque's elements from the front to the back.
+func (d *Deque) ToSlice() []interface{} {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	values := make([]interface{}, d.size)
+	for i := range values {
+		values[i] = containers.CleanBasicType(d.buffer[d.index(i)])
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the deque from the front to the back.
+func (d *Deque) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(d.ToSlice())
+}
+
+/** Display Functions **/
+
+// Display returns a string representation of the deque.
+func (d *Deque) Display() string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var b strings.Builder
+	b.WriteString("[")
+	for i := 0; i < d.size; i++ {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(fmt.Sprint(containers.CleanBasicType(d.buffer[d.index(i)])))
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+/** Ring Buffer **/
+
+// index returns the position in the buffer of the element at the given index of the deque
+func (d *Deque) index(i int) int {
+	return (d.head + i) & (len(d.buffer) - 1)
+}
+
+// checkIndex panics if the index does not point at an element of the deque
+func (d *Deque) checkIndex(index int64) {
+	if index < 0 || index >= int64(d.size) {
+		panic(fmt.Sprintf("index %d out of range for deque of size %d", index, d.size))
+	}
+}
+
+// grow doubles the capacity of the buffer if it is full
+func (d *Deque) grow() {
+	if d.size < len(d.buffer) {
+		return
+	}
+	d.resize(max(minCapacity, 2*len(d.buffer)))
+}
+
+// shrink halves the capacity of the buffer once it is a quarter full, so that alternating pushes & pops at the
+// boundary do not resize the buffer repeatedly
+func (d *Deque) shrink() {
+	if len(d.buffer) > minCapacity && d.size <= len(d.buffer)/4 {
+		d.resize(len(d.buffer) / 2)
+	}
+}
+
+// resize moves the elements to a new buffer of the given capacity, starting at its beginning
+func (d *Deque) resize(capacity int) {
+	buffer := make([]containers.Container, capacity)
+	if d.size > 0 {
+		if tail := d.head + d.size; tail <= len(d.buffer) {
+			copy(buffer, d.buffer[d.head:tail])
+		} else {
+			n := copy(buffer, d.buffer[d.head:])
+			copy(buffer[n:], d.buffer[:tail-len(d.buffer)])
+		}
+	}
+	d.buffer = buffer
+	d.head = 0
+}
+
+// replace swaps the contents of the deque with those of the given deque, which must not be used afterwards
+func (d *Deque) replace(other *Deque) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.buffer, d.head, d.size = other.buffer, other.head, other.size
+}
+
+/** Constructors **/
+
+// New instantiates a deque which can contain elements of the valueType's type, with the values provided (order is
+// preserved).
+func New(valueType containers.Container, values ...interface{}) *Deque {
+	d := &Deque{valueType: valueType}
+	for _, value := range values {
+		d.pushBack(valueType.Validate(value))
+	}
+	return d
+}
+
+// NewInt instantiates a deque which can contain integer elements
+func NewInt(values ...interface{}) *Deque {
+	return New(containers.IntContainer(0), values...)
+}
+
+// NewString instantiates a deque which can contain string elements
+func NewString(values ...interface{}) *Deque {
+	return New(containers.StringContainer(""), values...)
+}
+
+// NewFloat64 instantiates a deque which can contain float64 elements
+func NewFloat64(values ...interface{}) *Deque {
+	return New(containers.Float64Container(0), values...)
+}
+
+// NewInt64 instantiates a deque which can contain int64 elements
+func NewInt64(values ...interface{}) *Deque {
+	return New(containers.Int64Container(0), values...)
+}
+
+// NewUint64 instantiates a deque which can contain uint64 elements
+func NewUint64(values ...interface{}) *Deque {
+	return New(containers.Uint64Container(0), values...)
+}
+
+// NewInt8 instantiates a deque which can contain int8 elements
+func NewInt8(values ...interface{}) *Deque {
+	return New(containers.Int8Container(0), values...)
+}
+
+// NewInt16 instantiates a deque which can contain int16 elements
+func NewInt16(values ...interface{}) *Deque {
+	return New(containers.Int16Container(0), values...)
+}
+
+// NewInt32 instantiates a deque which can contain int32 elements
+func NewInt32(values ...interface{}) *Deque {
+	return New(containers.Int32Container(0), values...)
+}
+
+// NewUint instantiates a deque which can contain uint elements
+func NewUint(values ...interface{}) *Deque {
+	return New(containers.UintContainer(0), values...)
+}
+
+// NewUint8 instantiates a deque which can contain uint8 elements
+func NewUint8(values ...interface{}) *Deque {
+	return New(containers.Uint8Container(0), values...)
+}
+
+// NewUint16 instantiates a deque which can contain uint16 elements
+func NewUint16(values ...interface{}) *Deque {
+	return New(containers.Uint16Container(0), values...)
+}
+
+// NewUint32 instantiates a deque which can contain uint32 elements
+func NewUint32(values ...interface{}) *Deque {
+	return New(containers.Uint32Container(0), values...)
+}
+
+// NewFloat32 instantiates a deque which can contain float32 elements
+func NewFloat32(values ...interface{}) *Deque {
+	return New(containers.Float32Container(0), values...)
+}
+
+// NewRune instantiates a deque which can contain rune elements
+func NewRune(values ...interface{}) *Deque {
+	return New(containers.RuneContainer(0), values...)
+}
+
+// NewBool instantiates a deque which can contain bool elements
+func NewBool(values ...interface{}) *Deque {
+	return New(containers.BoolContainer(false), values...)
+}
+
+// NewTime instantiates a deque which can contain time.Time elements
+func NewTime(values ...interface{}) *Deque {
+	return New(containers.TimeContainer{}, values...)
+}
+
+// NewDuration instantiates a deque which can contain time.Duration elements
+func NewDuration(values ...interface{}) *Deque {
+	return New(containers.DurationContainer(0), values...)
+}
+
+// NewBytes instantiates a deque which can contain []byte elements
+func NewBytes(values ...interface{}) *Deque {
+	return New(containers.BytesContainer{}, values...)
+}
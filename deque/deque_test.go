@@ -0,0 +1,236 @@
+package deque
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/lists"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestDeque_PushPop(t *testing.T) {
+	d := NewInt()
+	assert.Nil(t, d.PopFront())
+	assert.Nil(t, d.PopBack())
+	assert.Nil(t, d.Front())
+	assert.Nil(t, d.Back())
+
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+	assert.Equal(t, []interface{}{0, 1, 2, 3}, d.ToSlice())
+	assert.Equal(t, 0, d.Front())
+	assert.Equal(t, 3, d.Back())
+
+	assert.Equal(t, 0, d.PopFront())
+	assert.Equal(t, 3, d.PopBack())
+	assert.Equal(t, []interface{}{1, 2}, d.ToSlice())
+	assert.Equal(t, int64(2), d.Size())
+}
+
+func TestDeque_TryPush(t *testing.T) {
+	d := NewInt()
+	var typeError *containers.TypeError
+	assert.ErrorAs(t, d.TryPushBack("a"), &typeError)
+	assert.ErrorAs(t, d.TryPushFront("a"), &typeError)
+	assert.True(t, d.Empty())
+	assert.Panics(t, func() { d.PushBack("a") })
+
+	assert.NoError(t, d.TryPushBack(2))
+	assert.NoError(t, d.TryPushFront(1))
+	assert.Equal(t, []interface{}{1, 2}, d.ToSlice())
+}
+
+func TestDeque_Grow(t *testing.T) {
+	d := NewInt()
+	// Wrap the ring buffer around before growing it
+	for i := 0; i < minCapacity/2; i++ {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	assert.Equal(t, int64(minCapacity), d.Cap())
+	d.PushBack(minCapacity / 2)
+	assert.Equal(t, int64(2*minCapacity), d.Cap())
+
+	expected := make([]interface{}, 0, minCapacity+1)
+	for i := -minCapacity / 2; i <= minCapacity/2; i++ {
+		expected = append(expected, i)
+	}
+	assert.Equal(t, expected, d.ToSlice())
+}
+
+func TestDeque_Shrink(t *testing.T) {
+	d := NewInt()
+	for i := 0; i < 100; i++ {
+		d.PushBack(i)
+	}
+	assert.Equal(t, int64(128), d.Cap())
+	for i := 0; i < 95; i++ {
+		assert.Equal(t, i, d.PopFront())
+	}
+	assert.Equal(t, int64(16), d.Cap())
+	assert.Equal(t, []interface{}{95, 96, 97, 98, 99}, d.ToSlice())
+
+	d.Clear()
+	assert.True(t, d.Empty())
+	assert.Equal(t, int64(0), d.Cap())
+	d.PushFront(1)
+	assert.Equal(t, []interface{}{1}, d.ToSlice())
+}
+
+func TestDeque_At(t *testing.T) {
+	d := NewInt()
+	for i := 0; i < 5; i++ {
+		d.PushFront(i)
+	}
+	for i := int64(0); i < 5; i++ {
+		assert.Equal(t, 4-int(i), d.At(i))
+	}
+	assert.Panics(t, func() { d.At(-1) })
+	assert.Panics(t, func() { d.At(5) })
+}
+
+func TestDeque_Set(t *testing.T) {
+	d := NewInt(1, 2, 3)
+	d.Set(1, 5)
+	assert.Equal(t, []interface{}{1, 5, 3}, d.ToSlice())
+	assert.Panics(t, func() { d.Set(3, 1) })
+	assert.Panics(t, func() { d.Set(0, "a") })
+
+	var typeError *containers.TypeError
+	assert.ErrorAs(t, d.TrySet(0, "a"), &typeError)
+	assert.NoError(t, d.TrySet(2, 7))
+	assert.Equal(t, []interface{}{1, 5, 7}, d.ToSlice())
+}
+
+func TestDeque_Rotate(t *testing.T) {
+	testCases := map[string]struct {
+		n        int64
+		expected []interface{}
+	}{
+		"zero":          {0, []interface{}{0, 1, 2, 3, 4}},
+		"back":          {2, []interface{}{3, 4, 0, 1, 2}},
+		"back long":     {4, []interface{}{1, 2, 3, 4, 0}},
+		"front":         {-1, []interface{}{1, 2, 3, 4, 0}},
+		"front long":    {-3, []interface{}{3, 4, 0, 1, 2}},
+		"full rotation": {10, []interface{}{0, 1, 2, 3, 4}},
+		"modulo":        {12, []interface{}{3, 4, 0, 1, 2}},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := NewInt(0, 1, 2, 3, 4)
+			d.Rotate(testCase.n)
+			assert.Equal(t, testCase.expected, d.ToSlice())
+		})
+	}
+
+	t.Run("full buffer", func(t *testing.T) {
+		d := NewInt()
+		for i := 0; i < minCapacity; i++ {
+			d.PushBack(i)
+		}
+		d.Rotate(3)
+		assert.Equal(t, []interface{}{5, 6, 7, 0, 1, 2, 3, 4}, d.ToSlice())
+		d.Rotate(-3)
+		assert.Equal(t, []interface{}{0, 1, 2, 3, 4, 5, 6, 7}, d.ToSlice())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		d := NewInt()
+		d.Rotate(3)
+		assert.True(t, d.Empty())
+	})
+}
+
+func TestDeque_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	d := NewInt()
+	expected := []interface{}{}
+	for i := 0; i < 5000; i++ {
+		switch op := random.Intn(6); {
+		case op == 0:
+			d.PushFront(i)
+			expected = append([]interface{}{i}, expected...)
+		case op == 1:
+			d.PushBack(i)
+			expected = append(expected, i)
+		case op == 2 && len(expected) > 0:
+			assert.Equal(t, expected[0], d.PopFront())
+			expected = expected[1:]
+		case op == 3 && len(expected) > 0:
+			assert.Equal(t, expected[len(expected)-1], d.PopBack())
+			expected = expected[:len(expected)-1]
+		case op == 4 && len(expected) > 0:
+			index := random.Intn(len(expected))
+			d.Set(int64(index), i)
+			expected[index] = i
+		case op == 5 && len(expected) > 0:
+			n := random.Intn(2*len(expected)) - len(expected)
+			d.Rotate(int64(n))
+			k := ((n % len(expected)) + len(expected)) % len(expected)
+			expected = append(append([]interface{}{}, expected[len(expected)-k:]...), expected[:len(expected)-k]...)
+		}
+		assert.Equal(t, expected, d.ToSlice())
+	}
+}
+
+func TestDeque_Iteration(t *testing.T) {
+	d := NewString("a", "b", "c")
+
+	var forward []interface{}
+	for i, value := range d.All() {
+		assert.Equal(t, value, d.At(i))
+		forward = append(forward, value)
+	}
+	assert.Equal(t, []interface{}{"a", "b", "c"}, forward)
+
+	var backward []interface{}
+	for _, value := range d.Backward() {
+		backward = append(backward, value)
+	}
+	assert.Equal(t, []interface{}{"c", "b", "a"}, backward)
+
+	var values []interface{}
+	for it := d.Iter(); it.HasNext(); {
+		values = append(values, it.Next())
+	}
+	assert.Equal(t, forward, values)
+	assert.Equal(t, "[a b c]", d.Display())
+}
+
+func TestDeque_JSON(t *testing.T) {
+	d := NewInt(1, 2, 3)
+	data, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[1, 2, 3]`, string(data))
+
+	decoded := NewInt(9)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, d.ToSlice(), decoded.ToSlice())
+
+	assert.Error(t, json.Unmarshal([]byte(`[1, "a"]`), decoded))
+	assert.Equal(t, d.ToSlice(), decoded.ToSlice())
+	assert.ErrorIs(t, json.Unmarshal(data, &Deque{}), containers.ErrNoValueType)
+}
+
+func TestDeque_Snapshot(t *testing.T) {
+	d := NewString("a", "b")
+	d.PushFront("z")
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(d))
+	decoded := NewString()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	assert.Equal(t, []interface{}{"z", "a", "b"}, decoded.ToSlice())
+
+	// Snapshots of deques & lists are interchangeable
+	buf.Reset()
+	assert.NoError(t, d.Snapshot(&buf))
+	list := lists.NewString()
+	assert.NoError(t, list.Restore(&buf))
+	assert.Equal(t, d.ToSlice(), list.ToSlice())
+}
@@ -0,0 +1,57 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package deque
+
+import (
+	"encoding/json"
+	"github.com/soheltarir/gollections/containers"
+)
+
+// MarshalJSON implements json.Marshaler; the deque is encoded as a JSON array of its elements from the front to the
+// back.
+func (d *Deque) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.ToSlice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler; decodes a JSON array into the elements of the deque, replacing its
+// current contents. The elements are decoded into the Container type the deque was initialised with, hence the deque
+// must be created using New (or one of the NewX constructors) beforehand.
+// The deque is left unmodified in case of an error.
+func (d *Deque) UnmarshalJSON(data []byte) error {
+	if d.valueType == nil {
+		return containers.ErrNoValueType
+	}
+	elements, err := containers.UnmarshalJSONArray(d.valueType, data)
+	if err != nil {
+		return err
+	}
+	tempDeque := New(d.valueType)
+	for _, element := range elements {
+		tempDeque.pushBack(element)
+	}
+
+	d.replace(tempDeque)
+	return nil
+}
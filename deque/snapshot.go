@@ -0,0 +1,90 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package deque
+
+import (
+	"bytes"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/snapshot"
+	"io"
+)
+
+// Snapshot writes the elements of the deque from the front to the back in the binary format of the snapshot package.
+// Snapshots of deques, lists, queues & stacks are interchangeable.
+func (d *Deque) Snapshot(w io.Writer) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	encoder := snapshot.NewEncoder(w, snapshot.KindList, int64(d.size))
+	for i := 0; i < d.size; i++ {
+		if err := encoder.WriteElement(d.buffer[d.index(i)]); err != nil {
+			return err
+		}
+	}
+	return encoder.Close()
+}
+
+// Restore reads a snapshot written by Snapshot, replacing the current contents of the deque. The elements are
+// decoded into the Container type the deque was initialised with, hence the deque must be created using New (or one
+// of the NewX constructors) beforehand. Returns snapshot.ErrTruncated or snapshot.ErrChecksumMismatch if the snapshot
+// is incomplete or corrupted, in which case the deque is left unmodified.
+func (d *Deque) Restore(r io.Reader) error {
+	if d.valueType == nil {
+		return containers.ErrNoValueType
+	}
+	decoder, err := snapshot.NewDecoder(r, snapshot.KindList)
+	if err != nil {
+		return err
+	}
+	tempDeque := New(d.valueType)
+	for i := int64(0); i < decoder.Count; i++ {
+		element, err := decoder.ReadElement(d.valueType)
+		if err != nil {
+			return err
+		}
+		tempDeque.pushBack(element)
+	}
+	if err := decoder.Close(); err != nil {
+		return err
+	}
+
+	d.replace(tempDeque)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the binary format written by Snapshot.
+func (d *Deque) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := d.Snapshot(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder; similar to Restore, the deque must be created using New (or one of the NewX
+// constructors) beforehand.
+func (d *Deque) GobDecode(data []byte) error {
+	return d.Restore(bytes.NewReader(data))
+}
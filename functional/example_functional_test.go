@@ -0,0 +1,24 @@
+package functional_test
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/functional"
+	"github.com/soheltarir/gollections/lists"
+)
+
+func Example() {
+	list := lists.NewInt()
+	list.Insert(list.Begin(), 1, 2, 3, 4, 5, 6)
+
+	// Square the even elements of the list, without copying the list to a slice first
+	evens := functional.Filter(functional.Values(list.All()), func(v interface{}) bool { return v.(int)%2 == 0 })
+	squares := functional.Map(evens, func(v interface{}) int { return v.(int) * v.(int) })
+	fmt.Println(functional.Collect(squares))
+
+	total := functional.Reduce(squares, 0, func(acc, v int) int { return acc + v })
+	fmt.Println(total)
+
+	// Output:
+	// [4 16 36]
+	// 56
+}
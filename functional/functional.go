@@ -0,0 +1,246 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package functional exposes functional helpers over the iterators of the gollections data-structures.
+//
+// The lazy helpers (Map, Filter, Take, Skip, Zip, Chunk) return iterators which process the elements only when
+// iterated upon, and the eager helpers (Reduce, GroupBy, Partition, Any, All, Count, Collect) consume the iterators
+// provided. Hence, the helpers can be chained over a data-structure without copying it to a slice first:
+//
+//	evens := functional.Filter(queue.Values(), func(v interface{}) bool { return v.(int)%2 == 0 })
+//	total := functional.Reduce(evens, 0, func(acc int, v interface{}) int { return acc + v.(int) })
+package functional
+
+import (
+	"github.com/soheltarir/gollections"
+	"iter"
+)
+
+/** Adaptors **/
+
+// Values returns an iterator over the values of a key-value iterator, e.g., the elements of lists.LinkedList's All.
+func Values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range seq {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys of a key-value iterator, e.g., the elements of counter.Counter's All.
+func Keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range seq {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// FromIterator returns an iterator over the remaining elements of a gollections.Iterator.
+func FromIterator(it gollections.Iterator) iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+/** Lazy Functions **/
+
+// Map returns an iterator over the results of applying the mapper to each element of the iterator.
+func Map[T, U any](seq iter.Seq[T], mapper func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for value := range seq {
+			if !yield(mapper(value)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns an iterator over the elements of the iterator satisfying the predicate.
+func Filter[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range seq {
+			if predicate(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns an iterator over the first n elements of the iterator.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for value := range seq {
+			if !yield(value) {
+				return
+			}
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns an iterator over the elements of the iterator after the first n elements.
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		for value := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Zip returns an iterator over the pairs of elements of both the iterators at the same position. The iteration stops
+// once the shorter iterator is exhausted.
+func Zip[A, B any](first iter.Seq[A], second iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(second)
+		defer stop()
+
+		for a := range first {
+			b, ok := next()
+			if !ok || !yield(a, b) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns an iterator over consecutive slices of size elements of the iterator. The last chunk can have fewer
+// elements. Panics if the size is not positive.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size <= 0 {
+		panic("chunk size should be greater than zero")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for value := range seq {
+			chunk = append(chunk, value)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+/** Eager Functions **/
+
+// Collect returns the elements of the iterator as a slice.
+func Collect[T any](seq iter.Seq[T]) []T {
+	var values []T
+	for value := range seq {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Reduce folds the elements of the iterator into an accumulated value, starting with the initial value provided.
+func Reduce[T, A any](seq iter.Seq[T], initial A, reducer func(A, T) A) A {
+	accumulated := initial
+	for value := range seq {
+		accumulated = reducer(accumulated, value)
+	}
+	return accumulated
+}
+
+// GroupBy groups the elements of the iterator by the key returned by the keyFunc. The order of the elements within
+// a group is preserved.
+func GroupBy[T any, K comparable](seq iter.Seq[T], keyFunc func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for value := range seq {
+		key := keyFunc(value)
+		groups[key] = append(groups[key], value)
+	}
+	return groups
+}
+
+// Partition splits the elements of the iterator into the ones satisfying the predicate, and the ones which don't.
+func Partition[T any](seq iter.Seq[T], predicate func(T) bool) (matched []T, unmatched []T) {
+	for value := range seq {
+		if predicate(value) {
+			matched = append(matched, value)
+		} else {
+			unmatched = append(unmatched, value)
+		}
+	}
+	return matched, unmatched
+}
+
+// Any reports whether any element of the iterator satisfies the predicate. The iteration stops at the first match.
+func Any[T any](seq iter.Seq[T], predicate func(T) bool) bool {
+	for value := range seq {
+		if predicate(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// All reports whether all the elements of the iterator satisfy the predicate. The iteration stops at the first
+// mismatch. Returns true for an empty iterator.
+func All[T any](seq iter.Seq[T], predicate func(T) bool) bool {
+	for value := range seq {
+		if !predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of elements of the iterator satisfying the predicate.
+func Count[T any](seq iter.Seq[T], predicate func(T) bool) int {
+	count := 0
+	for value := range seq {
+		if predicate(value) {
+			count++
+		}
+	}
+	return count
+}
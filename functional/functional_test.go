@@ -0,0 +1,110 @@
+package functional
+
+import (
+	"github.com/soheltarir/gollections/lists"
+	"github.com/soheltarir/gollections/maps/counter"
+	"github.com/soheltarir/gollections/queue"
+	"github.com/soheltarir/gollections/trees/bst"
+	"github.com/soheltarir/gollections/trees/heaps"
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func isEven(v interface{}) bool {
+	return v.(int)%2 == 0
+}
+
+func TestValues(t *testing.T) {
+	ll := lists.NewInt()
+	ll.Insert(ll.Begin(), 1, 2, 3)
+	assert.Equal(t, []interface{}{1, 2, 3}, Collect(Values(ll.All())))
+	assert.Equal(t, []interface{}{1}, Collect(Take(Values(ll.All()), 1)))
+}
+
+func TestKeys(t *testing.T) {
+	c := counter.NewStringCounter("a", "b", "a")
+	assert.ElementsMatch(t, []interface{}{"a", "b"}, Collect(Keys(c.All())))
+	assert.Len(t, Collect(Take(Keys(c.All()), 1)), 1)
+}
+
+func TestFromIterator(t *testing.T) {
+	h := heaps.NewMinInt(3, 1, 2)
+	assert.ElementsMatch(t, []interface{}{1, 2, 3}, Collect(FromIterator(h.Iter())))
+	assert.Len(t, Collect(Take(FromIterator(h.Iter()), 2)), 2)
+}
+
+func TestMap(t *testing.T) {
+	q := queue.NewInt(1, 2, 3)
+	doubled := Map(q.Values(), func(v interface{}) int { return v.(int) * 2 })
+	assert.Equal(t, []int{2, 4, 6}, Collect(doubled))
+	assert.Equal(t, []int{2}, Collect(Take(doubled, 1)))
+}
+
+func TestFilter(t *testing.T) {
+	tree := bst.NewInt()
+	tree.InsertMany(4, 1, 3, 2)
+	assert.Equal(t, []interface{}{2, 4}, Collect(Filter(tree.InOrder(), isEven)))
+	assert.Equal(t, []interface{}{2}, Collect(Take(Filter(tree.InOrder(), isEven), 1)))
+}
+
+func TestTake(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3})
+	assert.Equal(t, []int{1, 2}, Collect(Take(seq, 2)))
+	assert.Equal(t, []int{1, 2, 3}, Collect(Take(seq, 5)))
+	assert.Empty(t, Collect(Take(seq, 0)))
+}
+
+func TestSkip(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3})
+	assert.Equal(t, []int{3}, Collect(Skip(seq, 2)))
+	assert.Empty(t, Collect(Skip(seq, 5)))
+	assert.Equal(t, []int{2}, Collect(Take(Skip(seq, 1), 1)))
+}
+
+func TestZip(t *testing.T) {
+	var pairs []string
+	for a, b := range Zip(slices.Values([]string{"a", "b", "c"}), slices.Values([]int{1, 2})) {
+		pairs = append(pairs, a+string(rune('0'+b)))
+	}
+	assert.Equal(t, []string{"a1", "b2"}, pairs)
+
+	for range Zip(slices.Values([]int{1, 2}), slices.Values([]int{1, 2})) {
+		break
+	}
+}
+
+func TestChunk(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3, 4, 5})
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, Collect(Chunk(seq, 2)))
+	assert.Equal(t, [][]int{{1, 2}}, Collect(Take(Chunk(seq, 2), 1)))
+	assert.Panics(t, func() { Chunk(seq, 0) })
+}
+
+func TestReduce(t *testing.T) {
+	h := heaps.NewMinInt(3, 1, 2)
+	total := Reduce(FromIterator(h.Iter()), 0, func(acc int, v interface{}) int { return acc + v.(int) })
+	assert.Equal(t, 6, total)
+}
+
+func TestGroupBy(t *testing.T) {
+	groups := GroupBy(slices.Values([]string{"apple", "avocado", "banana"}), func(v string) byte { return v[0] })
+	assert.Equal(t, map[byte][]string{'a': {"apple", "avocado"}, 'b': {"banana"}}, groups)
+}
+
+func TestPartition(t *testing.T) {
+	q := queue.NewInt(1, 2, 3, 4)
+	evens, odds := Partition(q.Values(), isEven)
+	assert.Equal(t, []interface{}{2, 4}, evens)
+	assert.Equal(t, []interface{}{1, 3}, odds)
+}
+
+func TestAnyAllCount(t *testing.T) {
+	seq := slices.Values([]int{2, 4, 5})
+	even := func(v int) bool { return v%2 == 0 }
+	assert.True(t, Any(seq, even))
+	assert.False(t, All(seq, even))
+	assert.True(t, All(slices.Values([]int{}), even))
+	assert.False(t, Any(slices.Values([]int{}), even))
+	assert.Equal(t, 2, Count(seq, even))
+}
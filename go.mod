@@ -1,8 +1,12 @@
 module github.com/soheltarir/gollections
 
-go 1.16
+go 1.23
+
+require github.com/stretchr/testify v1.7.0
 
 require (
-	github.com/stretchr/testify v1.7.0
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/tools v0.1.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
 )
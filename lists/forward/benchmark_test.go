@@ -0,0 +1,100 @@
+package forward
+
+import (
+	"github.com/soheltarir/gollections/lists"
+	"testing"
+)
+
+const benchmarkSize = 1000
+
+func BenchmarkList_PushBack(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := NewInt()
+		for j := 0; j < benchmarkSize; j++ {
+			l.PushBack(j)
+		}
+	}
+}
+
+func BenchmarkLinkedList_PushBack(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := lists.NewInt()
+		for j := 0; j < benchmarkSize; j++ {
+			l.PushBack(j)
+		}
+	}
+}
+
+func BenchmarkList_PushFront(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := NewInt()
+		for j := 0; j < benchmarkSize; j++ {
+			l.PushFront(j)
+		}
+	}
+}
+
+func BenchmarkLinkedList_PushFront(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := lists.NewInt()
+		for j := 0; j < benchmarkSize; j++ {
+			l.PushFront(j)
+		}
+	}
+}
+
+func BenchmarkList_Iterate(b *testing.B) {
+	l := NewInt()
+	for j := 0; j < benchmarkSize; j++ {
+		l.PushBack(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for it := l.Begin(); it != l.End(); it = it.Next() {
+			_ = it.Value()
+		}
+	}
+}
+
+func BenchmarkLinkedList_Iterate(b *testing.B) {
+	l := lists.NewInt()
+	for j := 0; j < benchmarkSize; j++ {
+		l.PushBack(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for it := l.Begin(); it != l.End(); it = it.Next() {
+			_ = it.Value()
+		}
+	}
+}
+
+func BenchmarkList_Sort(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		l := NewInt()
+		for j := benchmarkSize; j > 0; j-- {
+			l.PushBack(j)
+		}
+		b.StartTimer()
+		l.Sort()
+	}
+}
+
+func BenchmarkLinkedList_Sort(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		l := lists.NewInt()
+		for j := benchmarkSize; j > 0; j-- {
+			l.PushBack(j)
+		}
+		b.StartTimer()
+		l.Sort()
+	}
+}
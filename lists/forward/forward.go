@@ -0,0 +1,487 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package forward exposes a singly-linked list data-structure, modelled on C++'s std::forward_list.
+//
+// Forward lists are sequence containers that allow constant time insert and erase operations anywhere within the
+// sequence, after a known position. Unlike the doubly-linked lists of the lists package, each element only holds a
+// link to the element following it, halving the link overhead per element at the cost of only being iterable from
+// the front to the back. Hence, the insert and erase operations take the iterator preceding the position instead.
+package forward
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"iter"
+	"strings"
+	"sync"
+)
+
+// Node represents an element in a forward list
+type Node struct {
+	Value containers.Container
+	next  *Node
+}
+
+// List is a singly-linked sequence container that allows constant time insert and erase operations after any
+// position within the sequence, and iteration from the front to the back.
+//
+// All the operations on the list are thread-safe. The iterators of the list are fail-fast, i.e., once the list is
+// structurally modified, the iterators created before the modification report containers.ErrConcurrentModification.
+// InsertAfter & EraseAfter keep the position iterator provided to them valid.
+type List struct {
+	// head is a sentinel node preceding the first element, hence insertions at the front need no special handling
+	head      Node
+	tail      *Node
+	size      int64
+	valueType containers.Container
+	// modCount is incremented on every structural modification of the list, to invalidate its iterators
+	modCount uint64
+	// beforeBegin & end are the iterators to the sentinel node & past-the-end of the list
+	beforeBegin *Iterator
+	end         *Iterator
+	mu          sync.RWMutex
+}
+
+/** Element Access **/
+
+// Front returns the value of the first element of the list, or nil if the list is empty.
+func (l *List) Front() interface{} {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.head.next == nil {
+		return nil
+	}
+	return containers.CleanBasicType(l.head.next.Value)
+}
+
+// Back returns the value of the last element of the list, or nil if the list is empty.
+func (l *List) Back() interface{} {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.size == 0 {
+		return nil
+	}
+	return containers.CleanBasicType(l.tail.Value)
+}
+
+/** Iterators **/
+
+// BeforeBegin returns an iterator pointing to the theoretical element preceding the first element of the list, to be
+// used as the position for inserting or erasing at the front of the list. The iterator cannot be dereferenced, and
+// remains valid across modifications of the list.
+func (l *List) BeforeBegin() *Iterator {
+	return l.beforeBegin
+}
+
+// Begin returns an iterator pointing to the first element of the list, or End() if the list is empty.
+func (l *List) Begin() *Iterator {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.iteratorAt(l.head.next)
+}
+
+// End returns an iterator referring to the past-the-end element of the list. The iterator is shared, hence it can be
+// compared with the iterators returned by Next, and remains valid across modifications of the list.
+func (l *List) End() *Iterator {
+	return l.end
+}
+
+// All returns an iterator over the index-value pairs of the list from the front to the back.
+// The list is read locked during the iteration, hence the loop body must not modify the list.
+func (l *List) All() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		l.mu.RLock()
+		defer l.mu.RUnlock()
+
+		var index int64
+		for node := l.head.next; node != nil; node = node.next {
+			if !yield(index, containers.CleanBasicType(node.Value)) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+/** Modifiers **/
+
+// PushFront inserts a new element at the beginning of the list, right before its current first element.
+// Panics if an invalid type is provided.
+func (l *List) PushFront(val interface{}) {
+	l.pushFront(l.valueType.Validate(val))
+}
+
+// TryPushFront is similar to PushFront, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *List) TryPushFront(val interface{}) error {
+	element, err := containers.TryValidate(l.valueType, val)
+	if err != nil {
+		return err
+	}
+	l.pushFront(element)
+	return nil
+}
+
+func (l *List) pushFront(element containers.Container) {
+	node := &Node{Value: element}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.linkAfter(&l.head, node, node, 1)
+}
+
+// PushBack adds a new element at the end of the list, after its current last element.
+// Panics if an invalid type is provided.
+func (l *List) PushBack(val interface{}) {
+	l.pushBack(l.valueType.Validate(val))
+}
+
+// TryPushBack is similar to PushBack, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *List) TryPushBack(val interface{}) error {
+	element, err := containers.TryValidate(l.valueType, val)
+	if err != nil {
+		return err
+	}
+	l.pushBack(element)
+	return nil
+}
+
+func (l *List) pushBack(element containers.Container) {
+	node := &Node{Value: element}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.linkAfter(l.tail, node, node, 1)
+}
+
+// PopFront removes the first element of the list, and returns its value. Returns nil if the list is empty.
+func (l *List) PopFront() interface{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	first := l.head.next
+	if first == nil {
+		return nil
+	}
+	l.unlinkAfter(&l.head, first.next)
+	return containers.CleanBasicType(first.Value)
+}
+
+// InsertAfter inserts the elements after the element at the specified position, and returns an iterator pointing to
+// the last inserted element (or the position if no elements are provided). The position remains valid.
+// Panics if an invalid type or position is provided.
+func (l *List) InsertAfter(it *Iterator, elements ...interface{}) *Iterator {
+	last, err := l.TryInsertAfter(it, elements...)
+	if err != nil {
+		panic(err)
+	}
+	return last
+}
+
+// TryInsertAfter is similar to InsertAfter, but returns an error instead of panicking if an invalid type or
+// position is provided.
+func (l *List) TryInsertAfter(it *Iterator, elements ...interface{}) (*Iterator, error) {
+	var head, tail *Node
+	for _, element := range elements {
+		value, err := containers.TryValidate(l.valueType, element)
+		if err != nil {
+			return nil, err
+		}
+		node := &Node{Value: value}
+		if head == nil {
+			head = node
+		} else {
+			tail.next = node
+		}
+		tail = node
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.checkPosition(it); err != nil {
+		return nil, err
+	}
+	if it.node == nil {
+		return nil, errOutOfBounds
+	}
+	if head == nil {
+		return it, nil
+	}
+	l.linkAfter(it.node, head, tail, int64(len(elements)))
+	it.refresh()
+	return l.iteratorAt(tail), nil
+}
+
+// EraseAfter removes from the list either the element following the position, when a single iterator is provided,
+// or the range of elements between the iterator bounds, when two iterators are provided; i.e., the elements in
+// (first,last), excluding both the bounds. The iterators remain valid.
+func (l *List) EraseAfter(iterators ...*Iterator) error {
+	if len(iterators) > 2 || len(iterators) == 0 {
+		return fmt.Errorf("please provide a single iterator or the iterator bounds (i.e., only two iterators)")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, it := range iterators {
+		if err := l.checkPosition(it); err != nil {
+			return err
+		}
+	}
+	first := iterators[0]
+	if first.node == nil {
+		return errOutOfBounds
+	}
+	var last *Node
+	if len(iterators) == 1 {
+		if first.node.next == nil {
+			return fmt.Errorf("there's no element after the position")
+		}
+		last = first.node.next.next
+	} else {
+		last = iterators[1].node
+		if first.node == last {
+			// The range is empty, there's nothing to erase
+			return nil
+		}
+		// Validate the range before erasing any element
+		for node := first.node.next; node != last; node = node.next {
+			if node == nil {
+				return fmt.Errorf("the last iterator is not reachable from the first iterator")
+			}
+		}
+	}
+	l.unlinkAfter(first.node, last)
+	for _, it := range iterators {
+		it.refresh()
+	}
+	return nil
+}
+
+// Clear removes all the elements from the list, leaving it with a size of 0.
+func (l *List) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.head.next, l.tail, l.size = nil, &l.head, 0
+	l.modCount++
+}
+
+/** Capacity Functions **/
+
+// Size returns the number of elements in the list
+func (l *List) Size() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.size
+}
+
+// Empty reports whether the list is empty
+func (l *List) Empty() bool {
+	return l.Size() == 0
+}
+
+/** Collection Functions **/
+
+// ToSlice returns the values of the list's elements from the front to the back.
+func (l *List) ToSlice() []interface{} {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	values := make([]interface{}, 0, l.size)
+	for node := l.head.next; node != nil; node = node.next {
+		values = append(values, containers.CleanBasicType(node.Value))
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing the list from the front to the back.
+func (l *List) Iter() gollections.Iterator {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return &listIterator{list: l, next: l.head.next, modCount: l.modCount}
+}
+
+/** Display Functions **/
+
+// Display returns a string representation of the list.
+func (l *List) Display() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var b strings.Builder
+	for node := l.head.next; node != nil; node = node.next {
+		if node != l.head.next {
+			b.WriteString(" -> ")
+		}
+		_, _ = fmt.Fprintf(&b, "%v", node.Value.Key())
+	}
+	return b.String()
+}
+
+/** Helpers **/
+
+// linkAfter links the chain of count nodes from head to tail after the node provided.
+// The caller must hold the list's write lock.
+func (l *List) linkAfter(position, head, tail *Node, count int64) {
+	tail.next = position.next
+	position.next = head
+	if position == l.tail {
+		l.tail = tail
+	}
+	l.size += count
+	l.modCount++
+}
+
+// unlinkAfter unlinks the nodes following the node provided up to (excluding) the last node, which may be nil for
+// unlinking all the following nodes. The caller must hold the list's write lock.
+func (l *List) unlinkAfter(position, last *Node) {
+	for node := position.next; node != last; node = node.next {
+		l.size--
+	}
+	position.next = last
+	if last == nil {
+		l.tail = position
+	}
+	l.modCount++
+}
+
+// iteratorAt returns an iterator pointing to the node provided. The caller must hold the list's lock.
+func (l *List) iteratorAt(node *Node) *Iterator {
+	if node == nil {
+		return l.end
+	}
+	return &Iterator{list: l, node: node, modCount: l.modCount}
+}
+
+/** Constructors **/
+
+// New constructs an empty forward list, with no elements, of the Container type provided.
+func New(valueType containers.Container) *List {
+	l := &List{valueType: valueType}
+	l.tail = &l.head
+	l.beforeBegin = &Iterator{list: l, node: &l.head}
+	l.end = &Iterator{list: l}
+	return l
+}
+
+// NewInt constructs an empty integer forward list, with no elements.
+func NewInt() *List {
+	return New(containers.IntContainer(0))
+}
+
+// NewString constructs an empty string forward list, with no elements.
+func NewString() *List {
+	return New(containers.StringContainer(""))
+}
+
+// NewFloat64 constructs an empty float64 forward list, with no elements.
+func NewFloat64() *List {
+	return New(containers.Float64Container(0))
+}
+
+// NewInt64 constructs an empty int64 forward list, with no elements.
+func NewInt64() *List {
+	return New(containers.Int64Container(0))
+}
+
+// NewUint64 constructs an empty uint64 forward list, with no elements.
+func NewUint64() *List {
+	return New(containers.Uint64Container(0))
+}
+
+// NewInt8 constructs an empty int8 forward list, with no elements.
+func NewInt8() *List {
+	return New(containers.Int8Container(0))
+}
+
+// NewInt16 constructs an empty int16 forward list, with no elements.
+func NewInt16() *List {
+	return New(containers.Int16Container(0))
+}
+
+// NewInt32 constructs an empty int32 forward list, with no elements.
+func NewInt32() *List {
+	return New(containers.Int32Container(0))
+}
+
+// NewUint constructs an empty uint forward list, with no elements.
+func NewUint() *List {
+	return New(containers.UintContainer(0))
+}
+
+// NewUint8 constructs an empty uint8 forward list, with no elements.
+func NewUint8() *List {
+	return New(containers.Uint8Container(0))
+}
+
+// NewUint16 constructs an empty uint16 forward list, with no elements.
+func NewUint16() *List {
+	return New(containers.Uint16Container(0))
+}
+
+// NewUint32 constructs an empty uint32 forward list, with no elements.
+func NewUint32() *List {
+	return New(containers.Uint32Container(0))
+}
+
+// NewFloat32 constructs an empty float32 forward list, with no elements.
+func NewFloat32() *List {
+	return New(containers.Float32Container(0))
+}
+
+// NewRune constructs an empty rune forward list, with no elements.
+func NewRune() *List {
+	return New(containers.RuneContainer(0))
+}
+
+// NewBool constructs an empty bool forward list, with no elements.
+func NewBool() *List {
+	return New(containers.BoolContainer(false))
+}
+
+// NewTime constructs an empty time.Time forward list, with no elements.
+func NewTime() *List {
+	return New(containers.TimeContainer{})
+}
+
+// NewDuration constructs an empty time.Duration forward list, with no elements.
+func NewDuration() *List {
+	return New(containers.DurationContainer(0))
+}
+
+// NewBytes constructs an empty byte slice forward list, with no elements.
+func NewBytes() *List {
+	return New(containers.BytesContainer{})
+}
@@ -0,0 +1,312 @@
+package forward
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+// assertTail asserts that the tail & the size of the list match its nodes
+func assertTail(t *testing.T, l *List) {
+	t.Helper()
+	tail, size := &l.head, int64(0)
+	for node := l.head.next; node != nil; node = node.next {
+		tail = node
+		size++
+	}
+	assert.Same(t, tail, l.tail)
+	assert.Equal(t, size, l.size)
+}
+
+func TestNew(t *testing.T) {
+	l := NewInt()
+	assert.True(t, l.Empty())
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+	assert.Nil(t, l.PopFront())
+	assert.Equal(t, l.End(), l.Begin())
+	assert.Equal(t, "", l.Display())
+	assert.Equal(t, containers.IntContainer(0), l.valueType)
+	assert.Equal(t, containers.StringContainer(""), NewString().valueType)
+	assert.Equal(t, containers.Float64Container(0), NewFloat64().valueType)
+	assert.Equal(t, containers.Int64Container(0), NewInt64().valueType)
+	assert.Equal(t, containers.Uint64Container(0), NewUint64().valueType)
+	assert.Equal(t, containers.Int8Container(0), NewInt8().valueType)
+	assert.Equal(t, containers.Int16Container(0), NewInt16().valueType)
+	assert.Equal(t, containers.Int32Container(0), NewInt32().valueType)
+	assert.Equal(t, containers.UintContainer(0), NewUint().valueType)
+	assert.Equal(t, containers.Uint8Container(0), NewUint8().valueType)
+	assert.Equal(t, containers.Uint16Container(0), NewUint16().valueType)
+	assert.Equal(t, containers.Uint32Container(0), NewUint32().valueType)
+	assert.Equal(t, containers.Float32Container(0), NewFloat32().valueType)
+	assert.Equal(t, containers.RuneContainer(0), NewRune().valueType)
+	assert.Equal(t, containers.BoolContainer(false), NewBool().valueType)
+	assert.Equal(t, containers.TimeContainer{}, NewTime().valueType)
+	assert.Equal(t, containers.DurationContainer(0), NewDuration().valueType)
+	assert.Equal(t, containers.BytesContainer{}, NewBytes().valueType)
+}
+
+func TestList_PushFront(t *testing.T) {
+	l := NewInt()
+	l.PushFront(2)
+	l.PushFront(1)
+	assert.Equal(t, []interface{}{1, 2}, l.ToSlice())
+	assertTail(t, l)
+	assert.Equal(t, 1, l.Front())
+	assert.Panics(t, func() { l.PushFront("3") })
+	var typeErr *containers.TypeError
+	assert.ErrorAs(t, l.TryPushFront("3"), &typeErr)
+	assert.NoError(t, l.TryPushFront(0))
+	assert.Equal(t, "0 -> 1 -> 2", l.Display())
+}
+
+func TestList_PushBack(t *testing.T) {
+	l := NewInt()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushFront(0)
+	assert.Equal(t, []interface{}{0, 1, 2}, l.ToSlice())
+	assertTail(t, l)
+	assert.Panics(t, func() { l.PushBack("3") })
+	assert.Error(t, l.TryPushBack("3"))
+	assert.NoError(t, l.TryPushBack(3))
+	assert.Equal(t, []interface{}{0, 1, 2, 3}, l.ToSlice())
+	assertTail(t, l)
+}
+
+func TestList_PopFront(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 1, 2)
+	assert.Equal(t, 1, l.PopFront())
+	assert.Equal(t, []interface{}{2}, l.ToSlice())
+	assertTail(t, l)
+	assert.Equal(t, 2, l.PopFront())
+	assert.Equal(t, []interface{}{}, l.ToSlice())
+	assertTail(t, l)
+
+	// The list remains usable
+	l.PushBack(3)
+	assert.Equal(t, []interface{}{3}, l.ToSlice())
+	assertTail(t, l)
+}
+
+func TestList_InsertAfter(t *testing.T) {
+	l := NewInt()
+	last := l.InsertAfter(l.BeforeBegin(), 1, 4)
+	assert.Equal(t, 4, last.Value())
+	it := l.InsertAfter(l.Begin(), 2, 3)
+	assert.Equal(t, 3, it.Value())
+	assert.Equal(t, []interface{}{1, 2, 3, 4}, l.ToSlice())
+	assertTail(t, l)
+
+	// Appending after the last element updates the back of the list
+	l.InsertAfter(it.Next(), 5)
+	assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, l.ToSlice())
+	assertTail(t, l)
+
+	// The position remains valid
+	position := l.Begin()
+	l.InsertAfter(position, 6)
+	assert.Equal(t, 6, position.Next().Value())
+	assert.Equal(t, position, l.InsertAfter(position))
+
+	_, err := l.TryInsertAfter(l.End(), 7)
+	assert.Error(t, err)
+	_, err = l.TryInsertAfter(NewInt().BeforeBegin(), 7)
+	assert.Error(t, err)
+	_, err = l.TryInsertAfter(l.Begin(), "7")
+	assert.Error(t, err)
+	assert.Panics(t, func() { l.InsertAfter(l.BeforeBegin(), "7") })
+	assert.Equal(t, []interface{}{1, 6, 2, 3, 4, 5}, l.ToSlice())
+	assertTail(t, l)
+}
+
+func TestList_EraseAfter(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 1, 2, 3, 4, 5)
+	assert.NoError(t, l.EraseAfter(l.BeforeBegin()))
+	assert.Equal(t, []interface{}{2, 3, 4, 5}, l.ToSlice())
+	assertTail(t, l)
+
+	first := l.Begin()
+	assert.NoError(t, l.EraseAfter(first))
+	assert.Equal(t, []interface{}{2, 4, 5}, l.ToSlice())
+	assertTail(t, l)
+	assert.Equal(t, 4, first.Next().Value())
+
+	// Erase the range (2, End)
+	assert.NoError(t, l.EraseAfter(first, l.End()))
+	assert.Equal(t, []interface{}{2}, l.ToSlice())
+	assertTail(t, l)
+	l.PushBack(3)
+	assert.Equal(t, []interface{}{2, 3}, l.ToSlice())
+	assertTail(t, l)
+
+	assert.Error(t, l.EraseAfter())
+	assert.Error(t, l.EraseAfter(l.End()))
+	assert.Error(t, l.EraseAfter(l.Begin().Next()))
+	assert.Error(t, l.EraseAfter(l.Begin().Next(), l.Begin()))
+	assert.Equal(t, []interface{}{2, 3}, l.ToSlice())
+	assertTail(t, l)
+
+	// Erase all the elements
+	assert.NoError(t, l.EraseAfter(l.BeforeBegin(), l.End()))
+	assert.Equal(t, []interface{}{}, l.ToSlice())
+	assertTail(t, l)
+}
+
+func TestList_EraseAfter_EmptyRange(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 1, 2, 3)
+	it := l.Begin()
+	assert.NoError(t, l.EraseAfter(it, it))
+	assert.Equal(t, []interface{}{1, 2, 3}, l.ToSlice())
+	assertTail(t, l)
+	assert.NoError(t, l.EraseAfter(it, it.Next()))
+	assert.Equal(t, []interface{}{1, 2, 3}, l.ToSlice())
+	assertTail(t, l)
+	assert.NoError(t, l.EraseAfter(l.BeforeBegin(), l.BeforeBegin()))
+	assert.Equal(t, []interface{}{1, 2, 3}, l.ToSlice())
+	assertTail(t, l)
+
+	// The last element is followed by End, hence (Back, End) is empty as well
+	back := it.Next().Next()
+	assert.NoError(t, l.EraseAfter(back, l.End()))
+	assert.Equal(t, []interface{}{1, 2, 3}, l.ToSlice())
+	assertTail(t, l)
+	assert.Error(t, l.EraseAfter(back, it))
+	assert.Equal(t, []interface{}{1, 2, 3}, l.ToSlice())
+	assertTail(t, l)
+}
+
+func TestList_Clear(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 1, 2, 3)
+	it := l.Begin()
+	l.Clear()
+	assert.Equal(t, []interface{}{}, l.ToSlice())
+	assertTail(t, l)
+	assert.Panics(t, func() { it.Next() })
+	l.PushBack(1)
+	assert.Equal(t, []interface{}{1}, l.ToSlice())
+	assertTail(t, l)
+}
+
+func TestList_Reverse(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 1, 2, 3)
+	l.Reverse()
+	assert.Equal(t, []interface{}{3, 2, 1}, l.ToSlice())
+	assertTail(t, l)
+	l.PushBack(0)
+	assert.Equal(t, []interface{}{3, 2, 1, 0}, l.ToSlice())
+	assertTail(t, l)
+
+	empty := NewInt()
+	empty.Reverse()
+	assert.Equal(t, []interface{}{}, empty.ToSlice())
+	assertTail(t, empty)
+}
+
+func TestList_Sort(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 5, 3, 1, 4, 2)
+	l.Sort()
+	assert.Equal(t, []interface{}{1, 2, 3, 4, 5}, l.ToSlice())
+	assertTail(t, l)
+
+	l.SortFunc(containers.Natural.Reverse())
+	assert.Equal(t, []interface{}{5, 4, 3, 2, 1}, l.ToSlice())
+	assertTail(t, l)
+	l.PushBack(0)
+	assert.Equal(t, []interface{}{5, 4, 3, 2, 1, 0}, l.ToSlice())
+	assertTail(t, l)
+}
+
+func TestList_Merge(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 1, 3, 5)
+	other := NewInt()
+	other.InsertAfter(other.BeforeBegin(), 2, 4, 6, 7)
+	l.Merge(other)
+	assert.Equal(t, []interface{}{1, 2, 3, 4, 5, 6, 7}, l.ToSlice())
+	assertTail(t, l)
+	assert.Equal(t, []interface{}{}, other.ToSlice())
+	assertTail(t, other)
+	other.PushBack(1)
+	assert.Equal(t, []interface{}{1}, other.ToSlice())
+	assertTail(t, other)
+
+	l.Merge(l)
+	assert.Equal(t, []interface{}{1, 2, 3, 4, 5, 6, 7}, l.ToSlice())
+	assertTail(t, l)
+	assert.Panics(t, func() { l.Merge(NewString()) })
+}
+
+func TestIterator(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 1, 2, 3)
+	var actual []interface{}
+	for it := l.Begin(); it != l.End(); it = it.Next() {
+		actual = append(actual, it.Value())
+	}
+	assert.Equal(t, []interface{}{1, 2, 3}, actual)
+
+	assert.Equal(t, 1, l.BeforeBegin().Next().Value())
+	assert.Panics(t, func() { l.BeforeBegin().Value() })
+	assert.Panics(t, func() { l.End().Next() })
+	_, err := l.End().TryValue()
+	assert.Error(t, err)
+
+	it := l.Begin()
+	l.PushFront(0)
+	_, err = it.TryNext()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+	_, err = it.TryValue()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+	assert.Error(t, l.EraseAfter(it))
+	assert.Equal(t, 0, l.BeforeBegin().Next().Value())
+}
+
+func TestList_All(t *testing.T) {
+	l := NewInt()
+	l.InsertAfter(l.BeforeBegin(), 1, 2, 3)
+	var actual []interface{}
+	for i, v := range l.All() {
+		assert.Equal(t, int(i)+1, v)
+		actual = append(actual, v)
+		if i == 1 {
+			break
+		}
+	}
+	assert.Equal(t, []interface{}{1, 2}, actual)
+
+	it := l.Iter()
+	assert.Nil(t, it.Value())
+	assert.Equal(t, 1, it.Next())
+	assert.Equal(t, 1, it.Value())
+	l.PushBack(4)
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { it.Next() })
+}
+
+func TestList_Concurrency(t *testing.T) {
+	l := NewInt()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				l.PushBack(i)
+				l.PushFront(j)
+				l.InsertAfter(l.BeforeBegin(), j)
+				l.PopFront()
+				_ = l.ToSlice()
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int64(1600), l.Size())
+	assert.Len(t, l.ToSlice(), 1600)
+}
@@ -0,0 +1,154 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package forward
+
+import (
+	"errors"
+	"github.com/soheltarir/gollections/containers"
+)
+
+// errOutOfBounds is reported when an iterator is moved or dereferenced past the bounds of the list
+var errOutOfBounds = errors.New("iterator crossed list's bounds")
+
+// Iterator is a stateful iterator for traversing a forward list from the front to the back.
+// Iterators are fail-fast; once the list is structurally modified (other than through InsertAfter or EraseAfter
+// with the iterator as the position), Next & Value panic with containers.ErrConcurrentModification instead of
+// traversing stale data.
+type Iterator struct {
+	list *List
+	node *Node
+	// modCount is the modification count of the list the iterator is valid for
+	modCount uint64
+}
+
+// Next returns the iterator to the next element in the list. Panics if the iterator reaches out of bounds, or with
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) Next() *Iterator {
+	next, err := it.TryNext()
+	if err != nil {
+		panic(err)
+	}
+	return next
+}
+
+// TryNext is similar to Next, but returns an error instead of panicking if the iterator reaches out of bounds, or
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) TryNext() (*Iterator, error) {
+	if it.node == nil {
+		return nil, errOutOfBounds
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if !it.valid() {
+		return nil, containers.ErrConcurrentModification
+	}
+	return it.list.iteratorAt(it.node.next), nil
+}
+
+// Value returns the current element's value. Panics if the iterator is out of bounds, or with
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) Value() interface{} {
+	value, err := it.TryValue()
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// TryValue is similar to Value, but returns an error instead of panicking if the iterator is out of bounds, or
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) TryValue() (interface{}, error) {
+	if it.node == nil || it.node == &it.list.head {
+		return nil, errOutOfBounds
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if !it.valid() {
+		return nil, containers.ErrConcurrentModification
+	}
+	return containers.CleanBasicType(it.node.Value), nil
+}
+
+// valid reports whether the list hasn't been modified since the iterator was created. The before-begin & the
+// past-the-end iterators are always valid. The caller must hold the list's lock.
+func (it *Iterator) valid() bool {
+	return it.node == nil || it.node == &it.list.head || it.list.modCount == it.modCount
+}
+
+// refresh keeps the iterator valid after its list has been modified through it.
+// The caller must hold the list's write lock.
+func (it *Iterator) refresh() {
+	if it.node == nil || it.node == &it.list.head {
+		return
+	}
+	it.modCount = it.list.modCount
+}
+
+// checkPosition returns an error if the iterator can't be used as a position in the list, i.e., it belongs to
+// another list or the list has been modified since it was created. The caller must hold the list's lock.
+func (l *List) checkPosition(it *Iterator) error {
+	if it.list != l {
+		return errors.New("the iterator doesn't belong to the list")
+	}
+	if !it.valid() {
+		return containers.ErrConcurrentModification
+	}
+	return nil
+}
+
+// listIterator implements gollections.Iterator for a forward list.
+type listIterator struct {
+	list     *List
+	current  *Node
+	next     *Node
+	modCount uint64
+}
+
+func (it *listIterator) HasNext() bool {
+	return it.next != nil
+}
+
+func (it *listIterator) Next() interface{} {
+	if it.next == nil {
+		panic("iterator has no more elements")
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if it.list.modCount != it.modCount {
+		panic(containers.ErrConcurrentModification)
+	}
+	it.current, it.next = it.next, it.next.next
+	return containers.CleanBasicType(it.current.Value)
+}
+
+func (it *listIterator) Value() interface{} {
+	if it.current == nil {
+		return nil
+	}
+	return containers.CleanBasicType(it.current.Value)
+}
@@ -0,0 +1,116 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package forward
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/lists/internal/linked"
+	"reflect"
+)
+
+/** Operations **/
+
+// Reverse reverses the order of the elements in the list.
+func (l *List) Reverse() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var previous *Node
+	first := l.head.next
+	for node := first; node != nil; {
+		next := node.next
+		node.next = previous
+		previous, node = node, next
+	}
+	l.head.next = previous
+	if first != nil {
+		l.tail = first
+	}
+	l.modCount++
+}
+
+// Sort sorts the elements of the list in place using the Less method of the elements. The sort is stable, i.e.,
+// equivalent elements retain their relative order.
+// - Time Complexity: O(n*log(n))
+// - Space Complexity: O(log(n))
+func (l *List) Sort() {
+	l.SortFunc(containers.Natural)
+}
+
+// SortFunc is similar to Sort, but the elements are ordered by the comparator instead of their Less method.
+func (l *List) SortFunc(comparator containers.Comparator) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.head.next = linked.Sort(links, l.head.next, l.size, comparator)
+	l.relink()
+}
+
+// Merge merges the other list into the list, provided both the lists are sorted (see Sort). The elements are
+// transferred without being copied, leaving the other list empty. The merge is stable, i.e., for equivalent
+// elements, the elements of the list precede the elements of the other list.
+// Panics if the lists contain elements of different types.
+func (l *List) Merge(other *List) {
+	l.MergeFunc(other, containers.Natural)
+}
+
+// MergeFunc is similar to Merge, but the elements are ordered by the comparator instead of their Less method.
+func (l *List) MergeFunc(other *List, comparator containers.Comparator) {
+	if other == l {
+		return
+	}
+	expected, actual := reflect.TypeOf(l.valueType), reflect.TypeOf(other.valueType)
+	if expected != actual {
+		panic(fmt.Sprintf("lists of different types; expected: %s, received: %s", expected, actual))
+	}
+	unlock := linked.LockPair(&l.mu, &other.mu)
+	defer unlock()
+
+	l.head.next = linked.Merge(links, l.head.next, other.head.next, comparator)
+	l.size += other.size
+	l.relink()
+	other.head.next, other.tail, other.size = nil, &other.head, 0
+	other.modCount++
+}
+
+/** Helpers **/
+
+// relink updates the tail of the list after its nodes have been re-ordered, and invalidates its iterators.
+// The caller must hold the list's write lock.
+func (l *List) relink() {
+	l.tail = &l.head
+	for l.tail.next != nil {
+		l.tail = l.tail.next
+	}
+	l.modCount++
+}
+
+// links gives the linked package access to the next links of the list's nodes
+var links = linked.Links[*Node]{
+	Next:    func(node *Node) *Node { return node.next },
+	SetNext: func(node, next *Node) { node.next = next },
+	Value:   func(node *Node) containers.Container { return node.Value },
+}
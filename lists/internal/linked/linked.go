@@ -0,0 +1,113 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package linked implements the algorithms shared by the linked lists of the lists package & its sub-packages, over
+// the next links of their nodes.
+package linked
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"sync"
+	"unsafe"
+)
+
+// Links provides access to the next link & the value of the nodes of type N, where the zero value of N (i.e. a nil
+// pointer) terminates a chain of nodes.
+type Links[N comparable] struct {
+	Next    func(node N) N
+	SetNext func(node, next N)
+	Value   func(node N) containers.Container
+}
+
+// Sort sorts the chain of size nodes starting from head using the next links, and returns the new head. The sort is
+// stable, and the last node of the sorted chain is terminated.
+func Sort[N comparable](links Links[N], head N, size int64, comparator containers.Comparator) N {
+	var end N
+	if size < 2 {
+		if head != end {
+			links.SetNext(head, end)
+		}
+		return head
+	}
+	middle := head
+	for i := int64(1); i < size/2; i++ {
+		middle = links.Next(middle)
+	}
+	right := links.Next(middle)
+	links.SetNext(middle, end)
+	return Merge(
+		links,
+		Sort(links, head, size/2, comparator),
+		Sort(links, right, size-size/2, comparator),
+		comparator,
+	)
+}
+
+// Merge merges two sorted chains of nodes using the next links, and returns the new head. For equivalent elements,
+// the nodes of a precede the nodes of b.
+func Merge[N comparable](links Links[N], a, b N, comparator containers.Comparator) N {
+	var head, tail, end N
+	appendNode := func(node N) {
+		if tail == end {
+			head = node
+		} else {
+			links.SetNext(tail, node)
+		}
+		tail = node
+	}
+	for a != end && b != end {
+		if comparator(links.Value(b), links.Value(a)) {
+			appendNode(b)
+			b = links.Next(b)
+		} else {
+			appendNode(a)
+			a = links.Next(a)
+		}
+	}
+	if a != end {
+		appendNode(a)
+	} else if b != end {
+		appendNode(b)
+	}
+	return head
+}
+
+// LockPair write-locks both the mutexes in the order of their addresses, so that concurrent operations on the same
+// pair of lists (e.g. a.Swap(b) & b.Swap(a)) can't deadlock. Returns the function to unlock both the mutexes.
+func LockPair(a, b *sync.RWMutex) func() {
+	if a == b {
+		a.Lock()
+		return a.Unlock
+	}
+	first, second := a, b
+	if uintptr(unsafe.Pointer(b)) < uintptr(unsafe.Pointer(a)) {
+		first, second = b, a
+	}
+	first.Lock()
+	second.Lock()
+	return func() {
+		second.Unlock()
+		first.Unlock()
+	}
+}
@@ -0,0 +1,88 @@
+package linked
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+type node struct {
+	value containers.Container
+	next  *node
+}
+
+var nodeLinks = Links[*node]{
+	Next:    func(n *node) *node { return n.next },
+	SetNext: func(n, next *node) { n.next = next },
+	Value:   func(n *node) containers.Container { return n.value },
+}
+
+func chain(values ...int) *node {
+	var head *node
+	for i := len(values) - 1; i >= 0; i-- {
+		head = &node{value: containers.IntContainer(values[i]), next: head}
+	}
+	return head
+}
+
+func values(head *node) []int {
+	var result []int
+	for n := head; n != nil; n = n.next {
+		result = append(result, int(n.value.(containers.IntContainer)))
+	}
+	return result
+}
+
+func TestSort(t *testing.T) {
+	assert.Nil(t, Sort(nodeLinks, nil, 0, containers.Natural))
+	assert.Equal(t, []int{7}, values(Sort(nodeLinks, chain(7), 1, containers.Natural)))
+
+	head := Sort(nodeLinks, chain(5, 3, 8, 1, 3, 9, 2), 7, containers.Natural)
+	assert.Equal(t, []int{1, 2, 3, 3, 5, 8, 9}, values(head))
+}
+
+func TestSort_Stable(t *testing.T) {
+	head := chain(3, 1, 3, 2)
+	first := head
+	third := head.next.next
+	sorted := Sort(nodeLinks, head, 4, containers.Natural)
+	assert.Equal(t, []int{1, 2, 3, 3}, values(sorted))
+	assert.Same(t, first, sorted.next.next)
+	assert.Same(t, third, sorted.next.next.next)
+}
+
+func TestMerge(t *testing.T) {
+	assert.Nil(t, Merge(nodeLinks, nil, nil, containers.Natural))
+	assert.Equal(t, []int{1, 2}, values(Merge(nodeLinks, nil, chain(1, 2), containers.Natural)))
+	assert.Equal(t, []int{1, 2}, values(Merge(nodeLinks, chain(1, 2), nil, containers.Natural)))
+
+	a, b := chain(1, 4, 6), chain(2, 4, 7, 9)
+	aFour := a.next
+	merged := Merge(nodeLinks, a, b, containers.Natural)
+	assert.Equal(t, []int{1, 2, 4, 4, 6, 7, 9}, values(merged))
+	assert.Same(t, aFour, merged.next.next)
+}
+
+func TestLockPair(t *testing.T) {
+	var a, b sync.RWMutex
+
+	unlock := LockPair(&a, &a)
+	unlock()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			LockPair(&a, &b)()
+		}()
+		go func() {
+			defer wg.Done()
+			LockPair(&b, &a)()
+		}()
+	}
+	wg.Wait()
+	assert.True(t, a.TryLock())
+	assert.True(t, b.TryLock())
+}
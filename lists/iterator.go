@@ -32,10 +32,14 @@ SOFTWARE.
 package lists
 
 import (
+	"errors"
 	"fmt"
 	"github.com/soheltarir/gollections/containers"
 )
 
+// errOutOfBounds is reported when an iterator is moved or dereferenced past the bounds of the list
+var errOutOfBounds = errors.New("iterator crossed list's bounds")
+
 type direction uint
 
 const (
@@ -44,39 +48,80 @@ const (
 )
 
 // Iterator is a stateful iterator for traversing a linked list.
-// Please note, that iteration over a list is not a thread-safe operation, and if parallel write operations are
-// being performed on the list, the traversal can provide stale and outdated data.
+// Iterators are fail-fast; once the list is structurally modified (other than through Insert, Erase or Splice with
+// the iterator as the position), Next & Value panic with containers.ErrConcurrentModification instead of traversing
+// stale data.
 type Iterator struct {
+	list        *LinkedList
 	currentNode *Node
 	direction   direction
 	index       int64
 	limit       int64
+	// modCount is the modification count of the list the iterator is valid for
+	modCount uint64
 }
 
 // Next returns the iterator to the next/previous element in the list based on the traversal direction. Panics if
-// the iterator reaches out of bounds
-// Please note, iteration over a list is not a thread-safe operation.
+// the iterator reaches out of bounds, or with containers.ErrConcurrentModification if the list has been modified.
 func (it *Iterator) Next() *Iterator {
-	currNode := it.currentNode
-	if currNode == nil {
-		panic("iterator crossed list's bounds")
-	}
-	nextIt := &Iterator{limit: it.limit, direction: it.direction}
-	switch it.direction {
-	case forwardDirection:
-		if it.currentNode.next == nil {
-			nextIt = endFwdIterator
-		} else {
-			nextIt.currentNode, nextIt.index = it.currentNode.next, it.index+1
-		}
-	case backwardDirection:
-		if it.currentNode.previous == nil {
-			nextIt = endBackIterator
-		} else {
-			nextIt.currentNode, nextIt.index = it.currentNode.previous, it.index-1
-		}
+	next, err := it.TryNext()
+	if err != nil {
+		panic(err)
+	}
+	return next
+}
+
+// TryNext is similar to Next, but returns an error instead of panicking if the iterator reaches out of bounds, or
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) TryNext() (*Iterator, error) {
+	if it.currentNode == nil {
+		return nil, errOutOfBounds
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if !it.valid() {
+		return nil, containers.ErrConcurrentModification
+	}
+	return it.move(it.step(it.currentNode), 1), nil
+}
+
+// Prev returns the iterator to the element preceding the current element based on the traversal direction, i.e.,
+// it moves the iterator a step back. The past-the-end iterators move to the last element of their traversal.
+// Panics if the iterator reaches out of bounds, or with containers.ErrConcurrentModification if the list has been
+// modified.
+func (it *Iterator) Prev() *Iterator {
+	prev, err := it.TryPrev()
+	if err != nil {
+		panic(err)
+	}
+	return prev
+}
+
+// TryPrev is similar to Prev, but returns an error instead of panicking if the iterator reaches out of bounds, or
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) TryPrev() (*Iterator, error) {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if !it.valid() {
+		return nil, containers.ErrConcurrentModification
+	}
+	var prev *Node
+	switch {
+	case it.currentNode != nil && it.direction == forwardDirection:
+		prev = it.currentNode.previous
+	case it.currentNode != nil:
+		prev = it.currentNode.next
+	case it.direction == forwardDirection:
+		prev = it.list.tail
+	default:
+		prev = it.list.head
+	}
+	if prev == nil {
+		return nil, errOutOfBounds
 	}
-	return nextIt
+	return it.move(prev, -1), nil
 }
 
 func (it *Iterator) IsEqual(it2 *Iterator) bool {
@@ -86,32 +131,193 @@ func (it *Iterator) IsEqual(it2 *Iterator) bool {
 	return false
 }
 
-// Advance moves the iterator forward by the no. of the steps provided
+// Advance moves the iterator by the no. of the steps provided; forward for positive steps & backward for negative
+// steps. The iterator is left untouched if an error is returned. The past-the-end iterators are shared by the list,
+// hence they're never moved in place; the moved iterator is only returned for them.
 func (it *Iterator) Advance(steps int) (*Iterator, error) {
-	if steps <= 0 {
-		return it, fmt.Errorf("step size should be greater than zero")
-	}
 	newItr := it
-	for steps > 0 {
-		newItr = newItr.Next()
-		steps--
+	var err error
+	for ; steps > 0 && err == nil; steps-- {
+		newItr, err = newItr.TryNext()
+	}
+	for ; steps < 0 && err == nil; steps++ {
+		newItr, err = newItr.TryPrev()
+	}
+	if err != nil {
+		return it, err
+	}
+	if it.currentNode == nil {
+		return newItr, nil
 	}
 	*it = *newItr
 	return it, nil
 }
 
-// Value returns the current element's value
+// Value returns the current element's value. Panics if the iterator is out of bounds, or with
+// containers.ErrConcurrentModification if the list has been modified.
 func (it *Iterator) Value() interface{} {
-	return containers.CleanBasicType(it.currentNode.Value)
+	value, err := it.TryValue()
+	if err != nil {
+		panic(err)
+	}
+	return value
 }
 
-// Index returns the current element's index in the list
+// TryValue is similar to Value, but returns an error instead of panicking if the iterator is out of bounds, or
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) TryValue() (interface{}, error) {
+	if it.currentNode == nil {
+		return nil, errOutOfBounds
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if !it.valid() {
+		return nil, containers.ErrConcurrentModification
+	}
+	return containers.CleanBasicType(it.currentNode.Value), nil
+}
+
+// Index returns the current element's index in the list. The index of End is the size of the list, and the index of
+// REnd is -1.
 func (it *Iterator) Index() int64 {
-	return it.index
+	if it.currentNode != nil {
+		return it.index
+	}
+	if it.direction == backwardDirection {
+		return -1
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	return it.list.size
+}
+
+// Distance returns the no. of steps required to move the first iterator to the last iterator, which is negative if
+// the last iterator precedes the first iterator in their traversal direction. The iterators should belong to the same
+// list, traverse it in the same direction, and have been created after the last modification of the list.
+func Distance(first, last *Iterator) (int64, error) {
+	if first.list != last.list {
+		return 0, fmt.Errorf("the iterators don't belong to the same list")
+	}
+	if first.direction != last.direction {
+		return 0, fmt.Errorf("the iterators should traverse the list in the same direction")
+	}
+	first.list.mu.RLock()
+	defer first.list.mu.RUnlock()
+
+	if !first.valid() || !last.valid() {
+		return 0, containers.ErrConcurrentModification
+	}
+	distance := last.position() - first.position()
+	if first.direction == backwardDirection {
+		distance = -distance
+	}
+	return distance, nil
+}
+
+// step returns the node succeeding the node provided in the direction of the iterator.
+func (it *Iterator) step(node *Node) *Node {
+	if it.direction == forwardDirection {
+		return node.next
+	}
+	return node.previous
+}
+
+// move returns a copy of the iterator pointing to the node provided, which is steps away from the current element in
+// the direction of the iterator. A nil node returns the past-the-end iterator. The caller must hold the list's lock.
+func (it *Iterator) move(node *Node, steps int64) *Iterator {
+	if node == nil {
+		if it.direction == forwardDirection {
+			return it.list.end
+		}
+		return it.list.rend
+	}
+	moved := *it
+	moved.currentNode = node
+	if it.currentNode == nil {
+		moved.index = it.position()
+		moved.limit = it.list.size - 1
+		moved.modCount = it.list.modCount
+	}
+	if it.direction == forwardDirection {
+		moved.index += steps
+	} else {
+		moved.index -= steps
+	}
+	return &moved
+}
+
+// position returns the index of the iterator's element. The caller must hold the list's lock.
+func (it *Iterator) position() int64 {
+	switch {
+	case it.currentNode != nil:
+		return it.index
+	case it.direction == forwardDirection:
+		return it.list.size
+	default:
+		return -1
+	}
+}
+
+// valid reports whether the list hasn't been modified since the iterator was created. The past-the-end iterators
+// are always valid. The caller must hold the list's lock.
+func (it *Iterator) valid() bool {
+	return it.currentNode == nil || it.list.modCount == it.modCount
 }
 
-// endFwdIterator is used for signifying the end of an iteration
-var endFwdIterator = &Iterator{currentNode: nil, direction: forwardDirection}
+// shift keeps the iterator valid after its list has been modified through it, where delta is the change in the
+// index of its element. The caller must hold the list's write lock.
+func (it *Iterator) shift(delta int64) {
+	if it.currentNode == nil {
+		return
+	}
+	it.index += delta
+	it.limit = it.list.size - 1
+	it.modCount = it.list.modCount
+}
 
-// endBackIterator is used for signifying the end of an iteration in reverse direction
-var endBackIterator = &Iterator{currentNode: nil, direction: backwardDirection}
+// checkPosition returns an error if the iterator can't be used as a position in the list, i.e., it belongs to
+// another list or the list has been modified since it was created. The caller must hold the list's lock.
+func (ll *LinkedList) checkPosition(it *Iterator) error {
+	if it.list != ll {
+		return fmt.Errorf("the iterator doesn't belong to the list")
+	}
+	if !it.valid() {
+		return containers.ErrConcurrentModification
+	}
+	return nil
+}
+
+// listIterator implements gollections.Iterator for a linked list, traversing it from the front to the back.
+type listIterator struct {
+	list     *LinkedList
+	current  *Node
+	next     *Node
+	modCount uint64
+}
+
+func (it *listIterator) HasNext() bool {
+	return it.next != nil
+}
+
+func (it *listIterator) Next() interface{} {
+	if it.next == nil {
+		panic("iterator has no more elements")
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if it.list.modCount != it.modCount {
+		panic(containers.ErrConcurrentModification)
+	}
+	it.current, it.next = it.next, it.next.next
+	return containers.CleanBasicType(it.current.Value)
+}
+
+func (it *listIterator) Value() interface{} {
+	if it.current == nil {
+		return nil
+	}
+	return containers.CleanBasicType(it.current.Value)
+}
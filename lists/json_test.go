@@ -0,0 +1,55 @@
+package lists
+
+import (
+	"encoding/json"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLinkedList_MarshalJSON(t *testing.T) {
+	ll := NewInt()
+	data, err := json.Marshal(ll)
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	ll.PushBack(1)
+	ll.PushBack(2)
+	ll.PushFront(0)
+	data, err = json.Marshal(ll)
+	assert.NoError(t, err)
+	assert.Equal(t, `[0,1,2]`, string(data))
+}
+
+func TestLinkedList_UnmarshalJSON(t *testing.T) {
+	ll := NewString()
+	ll.PushBack("stale")
+	assert.NoError(t, json.Unmarshal([]byte(`["a", "b", "c"]`), ll))
+	assert.Equal(t, []interface{}{"a", "b", "c"}, ll.ToSlice())
+	assert.Equal(t, int64(3), ll.Size())
+	assert.Equal(t, "c", ll.Back())
+
+	// The list is left unmodified on errors
+	assert.Error(t, json.Unmarshal([]byte(`["d", 1]`), ll))
+	assert.Error(t, json.Unmarshal([]byte(`{"a": 1}`), ll))
+	assert.Equal(t, []interface{}{"a", "b", "c"}, ll.ToSlice())
+
+	var zero LinkedList
+	assert.ErrorIs(t, json.Unmarshal([]byte(`[1]`), &zero), containers.ErrNoValueType)
+}
+
+func TestLinkedList_JSONRoundTrip(t *testing.T) {
+	type wrapper struct {
+		Values *LinkedList `json:"values"`
+	}
+	original := wrapper{Values: NewFloat64()}
+	original.Values.PushBack(1.5)
+	original.Values.PushBack(-2.0)
+	data, err := json.Marshal(original)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"values":[1.5,-2]}`, string(data))
+
+	decoded := wrapper{Values: NewFloat64()}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, original.Values.ToSlice(), decoded.Values.ToSlice())
+}
@@ -33,7 +33,9 @@ package lists
 
 import (
 	"fmt"
+	"github.com/soheltarir/gollections"
 	"github.com/soheltarir/gollections/containers"
+	"iter"
 	"strings"
 	"sync"
 )
@@ -47,33 +49,46 @@ type Node struct {
 
 // LinkedList is a sequence container that allow constant time insert and erase operations anywhere within the sequence,
 // and iteration in both directions.
+//
+// All the operations on the list are thread-safe. The iterators of the list are fail-fast, i.e., once the list is
+// structurally modified (elements are added, removed or re-ordered), the iterators created before the modification
+// report containers.ErrConcurrentModification instead of traversing stale or removed elements. Insert, Erase &
+// Splice keep the position iterator provided to them valid.
 type LinkedList struct {
 	head      *Node
 	tail      *Node
 	size      int64
 	valueType containers.Container
-	mu        sync.RWMutex
+	// modCount is incremented on every structural modification of the list, to invalidate its iterators
+	modCount uint64
+	// end & rend are the past-the-end iterators of the list in either direction
+	end  *Iterator
+	rend *Iterator
+	mu   sync.RWMutex
 }
 
 /** Element Access **/
 
 // Front returns the value of the first element of the linked list
 func (ll *LinkedList) Front() interface{} {
-	head := ll.head
-	if head == nil {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	if ll.head == nil {
 		return nil
 	}
-	// handle for nilNode
-	return containers.CleanBasicType(head.Value)
+	return containers.CleanBasicType(ll.head.Value)
 }
 
 // Back returns the value of the last element of the linked list
 func (ll *LinkedList) Back() interface{} {
-	tail := ll.tail
-	if tail == nil {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	if ll.tail == nil {
 		return nil
 	}
-	return containers.CleanBasicType(tail.Value)
+	return containers.CleanBasicType(ll.tail.Value)
 }
 
 /** Iterators */
@@ -86,29 +101,126 @@ func (ll *LinkedList) Begin() *Iterator {
 	if ll.size == 0 {
 		return ll.End()
 	}
-	head := ll.head
-	return &Iterator{currentNode: head, direction: forwardDirection, index: 0, limit: ll.size - 1}
+	return &Iterator{
+		list:        ll,
+		currentNode: ll.head,
+		direction:   forwardDirection,
+		index:       0,
+		limit:       ll.size - 1,
+		modCount:    ll.modCount,
+	}
 }
 
 // End Returns an iterator referring to the past-the-end element in the list container.
+// The iterator is shared, hence it can be compared with the iterators returned by Next, and remains valid across
+// modifications of the list.
 func (ll *LinkedList) End() *Iterator {
-	return endFwdIterator
+	return ll.end
 }
 
 // RBegin returns a reverse iterator pointing to the last element in the container (i.e., its reverse beginning).
 // Reverse iterators iterate backwards: increasing them moves them towards the beginning of the container.
 func (ll *LinkedList) RBegin() *Iterator {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
 	if ll.size == 0 {
 		return ll.REnd()
 	}
-	tail := ll.tail
-	return &Iterator{currentNode: tail, direction: backwardDirection, index: ll.size - 1, limit: ll.size - 1}
+	return &Iterator{
+		list:        ll,
+		currentNode: ll.tail,
+		direction:   backwardDirection,
+		index:       ll.size - 1,
+		limit:       ll.size - 1,
+		modCount:    ll.modCount,
+	}
 }
 
 // REnd returns a reverse iterator pointing to the theoretical element preceding the first element
 // in the list container
 func (ll *LinkedList) REnd() *Iterator {
-	return endBackIterator
+	return ll.rend
+}
+
+// At returns an iterator pointing to the element at the specified index, walking the list from whichever end is
+// closer to it. At(Size()) returns End(). Panics if the index is out of range.
+func (ll *LinkedList) At(index int64) *Iterator {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	if index < 0 || index > ll.size {
+		panic(fmt.Sprintf("index %d out of range for list of size %d", index, ll.size))
+	}
+	if index == ll.size {
+		return ll.end
+	}
+	var node *Node
+	if index < ll.size/2 {
+		node = ll.head
+		for i := int64(0); i < index; i++ {
+			node = node.next
+		}
+	} else {
+		node = ll.tail
+		for i := ll.size - 1; i > index; i-- {
+			node = node.previous
+		}
+	}
+	return ll.iteratorAt(node, index)
+}
+
+// iteratorAt returns a forward iterator pointing to the node provided, which is at the specified index.
+// The caller must hold the list's lock.
+func (ll *LinkedList) iteratorAt(node *Node, index int64) *Iterator {
+	return &Iterator{
+		list:        ll,
+		currentNode: node,
+		direction:   forwardDirection,
+		index:       index,
+		limit:       ll.size - 1,
+		modCount:    ll.modCount,
+	}
+}
+
+// All returns an iterator over the index-value pairs of the list from the front to the back.
+//
+//	for i, v := range list.All() {
+//		...
+//	}
+//
+// The list is read-locked for the duration of the iteration, and the lock is released once the loop completes or
+// breaks. Hence, the list must not be modified within the loop.
+func (ll *LinkedList) All() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		ll.mu.RLock()
+		defer ll.mu.RUnlock()
+
+		var index int64
+		for node := ll.head; node != nil; node = node.next {
+			if !yield(index, containers.CleanBasicType(node.Value)) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Backward returns an iterator over the index-value pairs of the list from the back to the front.
+// The locking semantics are the same as All.
+func (ll *LinkedList) Backward() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		ll.mu.RLock()
+		defer ll.mu.RUnlock()
+
+		index := ll.size - 1
+		for node := ll.tail; node != nil; node = node.previous {
+			if !yield(index, containers.CleanBasicType(node.Value)) {
+				return
+			}
+			index--
+		}
+	}
 }
 
 /** Modifiers */
@@ -117,108 +229,123 @@ func (ll *LinkedList) REnd() *Iterator {
 // This effectively increases the container size by one.
 // Panics if an invalid type is provided.
 func (ll *LinkedList) PushFront(val interface{}) {
-	element := ll.valueType.Validate(val)
+	ll.pushFront(ll.valueType.Validate(val))
+}
+
+// TryPushFront is similar to PushFront, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (ll *LinkedList) TryPushFront(val interface{}) error {
+	element, err := containers.TryValidate(ll.valueType, val)
+	if err != nil {
+		return err
+	}
+	ll.pushFront(element)
+	return nil
+}
+
+func (ll *LinkedList) pushFront(element containers.Container) {
 	node := &Node{Value: element}
 
 	ll.mu.Lock()
 	defer ll.mu.Unlock()
 
-	if ll.size == 0 {
-		ll.head, ll.tail = node, node
-	} else {
-		tmpNode := ll.head
-		tmpNode.previous = node
-		node.next = tmpNode
-		node.previous = nil
-		ll.head = node
-	}
-	ll.size++
+	ll.linkBefore(ll.head, node, node, 1)
 }
 
 // PushBack adds a new element at the end of the list container, after its current last element.
 // This effectively increases the container size by one.
 // Panics if an invalid type is provided.
 func (ll *LinkedList) PushBack(val interface{}) {
-	element := ll.valueType.Validate(val)
+	ll.pushBack(ll.valueType.Validate(val))
+}
+
+// TryPushBack is similar to PushBack, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (ll *LinkedList) TryPushBack(val interface{}) error {
+	element, err := containers.TryValidate(ll.valueType, val)
+	if err != nil {
+		return err
+	}
+	ll.pushBack(element)
+	return nil
+}
+
+func (ll *LinkedList) pushBack(element containers.Container) {
 	node := &Node{Value: element}
 
 	ll.mu.Lock()
 	defer ll.mu.Unlock()
 
-	if ll.size == 0 {
-		ll.head, ll.tail = node, node
-	} else {
-		tail := ll.tail
-		tail.next = node
-		node.previous = tail
-		node.next = nil
-		ll.tail = node
-	}
-	ll.size++
+	ll.linkBefore(nil, node, node, 1)
 }
 
 // PopFront deletes the first element of the list and returns it's value
 func (ll *LinkedList) PopFront() interface{} {
-	if ll.size == 0 {
-		return nil
-	}
-
 	ll.mu.Lock()
 	defer ll.mu.Unlock()
 
-	head := ll.head
-	next := head.next
-	if next != nil {
-		next.previous = nil
+	if ll.size == 0 {
+		return nil
 	}
-	ll.head = next
-	ll.size--
+	head, _, _ := ll.unlinkRange(ll.head, ll.head.next)
 	return containers.CleanBasicType(head.Value)
 }
 
 // PopBack deletes the last element of the list and returns it's value
 func (ll *LinkedList) PopBack() interface{} {
-	if ll.size == 0 {
-		return nil
-	}
-
 	ll.mu.Lock()
 	defer ll.mu.Unlock()
 
-	tail := ll.tail
-	previous := tail.previous
-	previous.next = nil
-	ll.tail = previous
-	ll.size--
+	if ll.size == 0 {
+		return nil
+	}
+	tail, _, _ := ll.unlinkRange(ll.tail, nil)
 	return containers.CleanBasicType(tail.Value)
 }
 
 // Insert extends the list by inserting new elements before the element at the specified position.
-// This effectively increases the list size by the amount of elements inserted.
-// Note: This operation is not thread safe.
+// This effectively increases the list size by the amount of elements inserted. The position iterator remains valid,
+// pointing to the same element.
+// Panics if an invalid type is provided, or with containers.ErrConcurrentModification if the iterator is stale.
 func (ll *LinkedList) Insert(it *Iterator, elements ...interface{}) {
 	tempList := New(ll.valueType)
 
 	for _, element := range elements {
 		tempList.PushBack(element)
 	}
-	if ll.size == 0 {
-		ll.head, ll.tail = tempList.head, tempList.tail
-		ll.size = tempList.size
-	} else {
-		tmpHead, tmpTail := tempList.head, tempList.tail
-		prev := it.currentNode.previous
-		if prev != nil {
-			prev.next = tmpHead
-			tmpHead.previous = prev
-		} else {
-			// This is the case wherein the elements are being pushed before the head of the list
-			ll.head = tmpHead
+	if err := ll.insertList(it, tempList); err != nil {
+		panic(err)
+	}
+}
+
+// TryInsert is similar to Insert, but returns a *containers.TypeError instead of panicking if any of the elements
+// is of an invalid type, or containers.ErrConcurrentModification if the iterator is stale. The list is left
+// unmodified in case of an error.
+func (ll *LinkedList) TryInsert(it *Iterator, elements ...interface{}) error {
+	tempList := New(ll.valueType)
+
+	for _, element := range elements {
+		if err := tempList.TryPushBack(element); err != nil {
+			return err
 		}
-		it.currentNode.previous = tmpTail
-		tmpTail.next = it.currentNode
-		ll.size += tempList.size
 	}
+	return ll.insertList(it, tempList)
+}
+
+// insertList splices the nodes of tempList before the element at the specified position.
+func (ll *LinkedList) insertList(it *Iterator, tempList *LinkedList) error {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	if err := ll.checkPosition(it); err != nil {
+		return err
+	}
+	if tempList.size == 0 {
+		return nil
+	}
+	ll.linkBefore(it.currentNode, tempList.head, tempList.tail, tempList.size)
+	it.shift(tempList.size)
+	return nil
 }
 
 // Clear Removes all elements from the list container (which are destroyed), and leaving the list with a size of 0.
@@ -227,29 +354,13 @@ func (ll *LinkedList) Clear() {
 	defer ll.mu.Unlock()
 
 	ll.head, ll.tail, ll.size = nil, nil, 0
-}
-
-func (ll *LinkedList) eraseSingle(it *Iterator) {
-	prev := it.currentNode.previous
-	next := it.currentNode.next
-	if prev != nil {
-		prev.next = next
-	}
-	if next != nil {
-		next.previous = prev
-	}
-	// Edge Handling
-	if it.currentNode == ll.head {
-		ll.head = next
-	}
-	if it.currentNode == ll.tail {
-		ll.tail = prev
-	}
-	ll.size--
+	ll.modCount++
 }
 
 // Erase removes from the list container either a single element or a range of elements ([first,last)).
-// Note: The bounds are including the first iterator & excluding the last iterator
+// Note: The bounds are including the first iterator & excluding the last iterator. The iterators provided must have
+// been created after the last modification of the list, otherwise containers.ErrConcurrentModification is returned.
+// The last iterator of a range remains valid.
 func (ll *LinkedList) Erase(iterators ...*Iterator) error {
 	if len(iterators) > 2 || len(iterators) == 0 {
 		return fmt.Errorf("please provide a single iterator or the iterator bounds (i.e., only two iterators)")
@@ -257,16 +368,47 @@ func (ll *LinkedList) Erase(iterators ...*Iterator) error {
 	ll.mu.Lock()
 	defer ll.mu.Unlock()
 
+	for _, it := range iterators {
+		if err := ll.checkPosition(it); err != nil {
+			return err
+		}
+	}
+	first := iterators[0]
+	if first.currentNode == nil {
+		return fmt.Errorf("cannot erase the end of the list")
+	}
 	if len(iterators) == 1 {
-		it := iterators[0]
-		ll.eraseSingle(it)
+		ll.unlinkRange(first.currentNode, first.currentNode.next)
 		return nil
 	}
 
-	first, last := iterators[0], iterators[1]
-
-	for it := first; !it.IsEqual(last); it = it.Next() {
-		ll.eraseSingle(it)
+	last := iterators[1]
+	if first.direction != last.direction {
+		return fmt.Errorf("the iterator bounds should traverse the list in the same direction")
+	}
+	if first.currentNode == last.currentNode {
+		// The range is empty, there's nothing to erase
+		return nil
+	}
+	// Validate the range before erasing any element
+	var count int64
+	for node := first.currentNode; node != last.currentNode; node = first.step(node) {
+		if node == nil {
+			return fmt.Errorf("the last iterator is not reachable from the first iterator")
+		}
+		count++
+	}
+	if first.direction == forwardDirection {
+		ll.unlinkRange(first.currentNode, last.currentNode)
+		last.shift(-count)
+	} else {
+		// The range is erased from the front to the back, i.e., from the element succeeding the last iterator
+		start := ll.head
+		if last.currentNode != nil {
+			start = last.currentNode.next
+		}
+		ll.unlinkRange(start, first.currentNode.next)
+		last.shift(0)
 	}
 	return nil
 }
@@ -275,28 +417,52 @@ func (ll *LinkedList) Erase(iterators ...*Iterator) error {
 
 // Size returns the length of the linked list
 func (ll *LinkedList) Size() int64 {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
 	return ll.size
 }
 
 // Empty returns whether the list container is empty (i.e. whether its size is 0).
 func (ll *LinkedList) Empty() bool {
-	if ll.size == 0 {
-		return true
+	return ll.Size() == 0
+}
+
+/** Collection Functions **/
+
+// ToSlice returns the values of the list's elements from the front to the back.
+func (ll *LinkedList) ToSlice() []interface{} {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	values := make([]interface{}, 0, ll.size)
+	for node := ll.head; node != nil; node = node.next {
+		values = append(values, containers.CleanBasicType(node.Value))
 	}
-	return false
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing the list from the front to the back.
+func (ll *LinkedList) Iter() gollections.Iterator {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	return &listIterator{list: ll, next: ll.head, modCount: ll.modCount}
 }
 
 /** Display Functions **/
 
 //Display returns a string representation of the linked list.
 func (ll *LinkedList) Display() string {
-	var b strings.Builder
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
 
-	for it := ll.Begin(); it != ll.End(); it = it.Next() {
-		if it.currentNode != ll.tail {
-			_, _ = fmt.Fprintf(&b, "%v <-> ", it.currentNode.Value.Key())
+	var b strings.Builder
+	for node := ll.head; node != nil; node = node.next {
+		if node != ll.tail {
+			_, _ = fmt.Fprintf(&b, "%v <-> ", node.Value.Key())
 		} else {
-			_, _ = fmt.Fprintf(&b, "%v", it.currentNode.Value.Key())
+			_, _ = fmt.Fprintf(&b, "%v", node.Value.Key())
 		}
 	}
 	return b.String()
@@ -306,10 +472,98 @@ func (ll *LinkedList) Display() string {
 
 // New constructs an empty container linked list, with no elements.
 func New(valueType containers.Container) *LinkedList {
-	return &LinkedList{valueType: valueType, mu: sync.RWMutex{}, size: 0}
+	ll := &LinkedList{valueType: valueType, mu: sync.RWMutex{}, size: 0}
+	ll.end = &Iterator{list: ll, direction: forwardDirection}
+	ll.rend = &Iterator{list: ll, direction: backwardDirection}
+	return ll
 }
 
 // NewInt constructs an empty integer linked list, with no elements.
 func NewInt() *LinkedList {
 	return New(containers.IntContainer(0))
 }
+
+// NewString constructs an empty string linked list, with no elements.
+func NewString() *LinkedList {
+	return New(containers.StringContainer(""))
+}
+
+// NewFloat64 constructs an empty float64 linked list, with no elements.
+func NewFloat64() *LinkedList {
+	return New(containers.Float64Container(0))
+}
+
+// NewInt64 constructs an empty int64 linked list, with no elements.
+func NewInt64() *LinkedList {
+	return New(containers.Int64Container(0))
+}
+
+// NewUint64 constructs an empty uint64 linked list, with no elements.
+func NewUint64() *LinkedList {
+	return New(containers.Uint64Container(0))
+}
+
+// NewInt8 constructs an empty int8 linked list, with no elements.
+func NewInt8() *LinkedList {
+	return New(containers.Int8Container(0))
+}
+
+// NewInt16 constructs an empty int16 linked list, with no elements.
+func NewInt16() *LinkedList {
+	return New(containers.Int16Container(0))
+}
+
+// NewInt32 constructs an empty int32 linked list, with no elements.
+func NewInt32() *LinkedList {
+	return New(containers.Int32Container(0))
+}
+
+// NewUint constructs an empty uint linked list, with no elements.
+func NewUint() *LinkedList {
+	return New(containers.UintContainer(0))
+}
+
+// NewUint8 constructs an empty uint8 linked list, with no elements.
+func NewUint8() *LinkedList {
+	return New(containers.Uint8Container(0))
+}
+
+// NewUint16 constructs an empty uint16 linked list, with no elements.
+func NewUint16() *LinkedList {
+	return New(containers.Uint16Container(0))
+}
+
+// NewUint32 constructs an empty uint32 linked list, with no elements.
+func NewUint32() *LinkedList {
+	return New(containers.Uint32Container(0))
+}
+
+// NewFloat32 constructs an empty float32 linked list, with no elements.
+func NewFloat32() *LinkedList {
+	return New(containers.Float32Container(0))
+}
+
+// NewRune constructs an empty rune linked list, with no elements.
+func NewRune() *LinkedList {
+	return New(containers.RuneContainer(0))
+}
+
+// NewBool constructs an empty bool linked list, with no elements.
+func NewBool() *LinkedList {
+	return New(containers.BoolContainer(false))
+}
+
+// NewTime constructs an empty time.Time linked list, with no elements.
+func NewTime() *LinkedList {
+	return New(containers.TimeContainer{})
+}
+
+// NewDuration constructs an empty time.Duration linked list, with no elements.
+func NewDuration() *LinkedList {
+	return New(containers.DurationContainer(0))
+}
+
+// NewBytes constructs an empty []byte linked list, with no elements.
+func NewBytes() *LinkedList {
+	return New(containers.BytesContainer{})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/soheltarir/gollections/containers"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestNewInt(t *testing.T) {
@@ -100,6 +101,33 @@ func TestLinkedList_PopBack(t *testing.T) {
 	assert.Equal(t, int64(1), ll.Size())
 }
 
+func TestLinkedList_PopBack_SingleElement(t *testing.T) {
+	ll := NewInt()
+	ll.PushBack(1)
+	assert.Equal(t, 1, ll.PopBack())
+	assert.True(t, ll.Empty())
+	assert.Nil(t, ll.Front())
+	assert.Nil(t, ll.Back())
+	assert.Equal(t, ll.End(), ll.Begin())
+
+	// The list remains usable
+	ll.PushBack(2)
+	ll.PushFront(1)
+	assert.Equal(t, []interface{}{1, 2}, ll.ToSlice())
+	assert.Equal(t, 2, ll.PopBack())
+	assert.Equal(t, 1, ll.PopBack())
+	assert.Nil(t, ll.PopBack())
+}
+
+func TestLinkedList_PopFront_SingleElement(t *testing.T) {
+	ll := NewInt()
+	ll.PushBack(1)
+	assert.Equal(t, 1, ll.PopFront())
+	assert.Nil(t, ll.Back())
+	ll.PushFront(2)
+	assert.Equal(t, 2, ll.Back())
+}
+
 func TestLinkedList_Empty(t *testing.T) {
 	ll := NewInt()
 	assert.True(t, ll.Empty())
@@ -181,6 +209,23 @@ func TestLinkedList_Erase(t *testing.T) {
 
 }
 
+func TestLinkedList_Erase_EmptyRange(t *testing.T) {
+	ll := NewInt()
+	ll.PushBack(1)
+	ll.PushBack(2)
+
+	it := ll.Begin()
+	assert.NoError(t, ll.Erase(it, it))
+	assert.Equal(t, []interface{}{1, 2}, ll.ToSlice())
+	assert.Equal(t, 1, it.Value())
+
+	rit := ll.RBegin()
+	assert.NoError(t, ll.Erase(rit, rit))
+	assert.Equal(t, []interface{}{1, 2}, ll.ToSlice())
+	assert.Equal(t, 2, rit.Value())
+	assert.Equal(t, int64(2), ll.Size())
+}
+
 func TestIterator_Next(t *testing.T) {
 	ll := NewInt()
 	ll.Insert(ll.Begin(), 1, 2)
@@ -210,6 +255,116 @@ func TestIterator_Advance(t *testing.T) {
 	it := ll.Begin()
 	_, err := it.Advance(-1)
 	assert.Error(t, err)
+	assert.Equal(t, 1, it.Value())
+
+	_, err = it.Advance(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, it.Value())
+	_, err = it.Advance(-1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, it.Value())
+	assert.Equal(t, int64(1), it.Index())
+	_, err = it.Advance(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, it.Value())
+	_, err = it.Advance(3)
+	assert.Error(t, err)
+	assert.Equal(t, 2, it.Value())
+
+	// The end iterators aren't moved in place
+	last, err := ll.End().Advance(-1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, last.Value())
+	assert.Equal(t, int64(3), ll.End().Index())
+
+	rIt, err := ll.RBegin().Advance(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rIt.Value())
+	_, err = rIt.Advance(-2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rIt.Value())
+}
+
+func TestIterator_Prev(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.Begin(), 1, 2, 3)
+
+	var actual []interface{}
+	for it := ll.End(); it.Index() > 0; {
+		it = it.Prev()
+		actual = append(actual, it.Value())
+	}
+	assert.Equal(t, []interface{}{3, 2, 1}, actual)
+
+	last := ll.End().Prev()
+	assert.Equal(t, int64(2), last.Index())
+	assert.Equal(t, ll.End(), last.Next())
+	assert.Panics(t, func() { ll.Begin().Prev() })
+
+	// Reverse iterators move towards the back of the list
+	first := ll.REnd().Prev()
+	assert.Equal(t, 1, first.Value())
+	assert.Equal(t, int64(0), first.Index())
+	assert.Equal(t, 2, first.Prev().Value())
+	assert.Equal(t, int64(1), first.Prev().Index())
+	_, err := ll.RBegin().TryPrev()
+	assert.Error(t, err)
+
+	_, err = NewInt().End().TryPrev()
+	assert.Error(t, err)
+
+	ll.PushBack(4)
+	_, err = last.TryPrev()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+	assert.Equal(t, 4, ll.End().Prev().Value())
+}
+
+func TestDistance(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.Begin(), 1, 2, 3, 4)
+
+	distance, err := Distance(ll.Begin(), ll.End())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), distance)
+	distance, err = Distance(ll.At(3), ll.At(1))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-2), distance)
+	distance, err = Distance(ll.RBegin(), ll.REnd())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), distance)
+	distance, err = Distance(ll.End(), ll.End())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), distance)
+
+	_, err = Distance(ll.Begin(), ll.REnd())
+	assert.Error(t, err)
+	_, err = Distance(ll.Begin(), NewInt().End())
+	assert.Error(t, err)
+
+	it := ll.Begin()
+	ll.PopFront()
+	_, err = Distance(it, ll.End())
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+}
+
+func TestLinkedList_At(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.Begin(), 1, 2, 3, 4, 5)
+	for i := int64(0); i < 5; i++ {
+		it := ll.At(i)
+		assert.Equal(t, int(i)+1, it.Value())
+		assert.Equal(t, i, it.Index())
+	}
+	assert.Equal(t, ll.End(), ll.At(5))
+	assert.Panics(t, func() { ll.At(6) })
+	assert.Panics(t, func() { ll.At(-1) })
+	empty := NewInt()
+	assert.Equal(t, empty.End(), empty.At(0))
+
+	// Erase the range [1, 3)
+	assert.NoError(t, ll.Erase(ll.At(1), ll.At(3)))
+	assert.Equal(t, []interface{}{1, 4, 5}, ll.ToSlice())
+	assert.Equal(t, 5, ll.At(2).Value())
 }
 
 func TestLinkedList_Display(t *testing.T) {
@@ -218,3 +373,101 @@ func TestLinkedList_Display(t *testing.T) {
 	expected := "1 <-> 2 <-> 3 <-> 4 <-> 5"
 	assert.Equal(t, expected, ll.Display())
 }
+
+func TestLinkedList_TryPushBack(t *testing.T) {
+	ll := NewInt()
+	assert.NoError(t, ll.TryPushBack(1))
+	var typeErr *containers.TypeError
+	assert.ErrorAs(t, ll.TryPushBack("a"), &typeErr)
+	assert.Equal(t, int64(1), ll.Size())
+}
+
+func TestLinkedList_TryPushFront(t *testing.T) {
+	ll := NewInt()
+	assert.NoError(t, ll.TryPushFront(1))
+	assert.Error(t, ll.TryPushFront(1.5))
+	assert.Equal(t, 1, ll.Front())
+}
+
+func TestLinkedList_TryInsert(t *testing.T) {
+	ll := NewInt()
+	assert.NoError(t, ll.TryInsert(ll.Begin(), 1, 2))
+	// The list should be left unmodified on a type mismatch
+	assert.Error(t, ll.TryInsert(ll.Begin(), 3, "four"))
+	assert.Equal(t, "1 <-> 2", ll.Display())
+}
+
+func TestNewFloat64(t *testing.T) {
+	ll := NewFloat64()
+	ll.PushBack(1.5)
+	assert.Equal(t, 1.5, ll.Front())
+	assert.Panics(t, func() { ll.PushBack(1) })
+}
+
+func TestNewTime(t *testing.T) {
+	now := time.Now()
+	ll := NewTime()
+	ll.PushBack(now)
+	assert.Equal(t, now, ll.Back())
+}
+
+func TestLinkedList_ToSlice(t *testing.T) {
+	ll := NewInt()
+	assert.Empty(t, ll.ToSlice())
+	ll.Insert(ll.Begin(), 1, 2, 3)
+	assert.Equal(t, []interface{}{1, 2, 3}, ll.ToSlice())
+}
+
+func TestLinkedList_Iter(t *testing.T) {
+	ll := NewString()
+	ll.Insert(ll.Begin(), "a", "b")
+	it := ll.Iter()
+	assert.Nil(t, it.Value())
+	var actual []interface{}
+	for it.HasNext() {
+		actual = append(actual, it.Next())
+	}
+	assert.Equal(t, []interface{}{"a", "b"}, actual)
+	assert.Equal(t, "b", it.Value())
+	assert.Panics(t, func() { it.Next() })
+}
+
+func TestLinkedList_All(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.Begin(), 1, 2, 3)
+	var indices []int64
+	var values []interface{}
+	for i, v := range ll.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int64{0, 1, 2}, indices)
+	assert.Equal(t, []interface{}{1, 2, 3}, values)
+
+	// Breaking early should release the read lock
+	for _, v := range ll.All() {
+		if v == 2 {
+			break
+		}
+	}
+	ll.PushBack(4)
+	assert.Equal(t, int64(4), ll.Size())
+}
+
+func TestLinkedList_Backward(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.Begin(), 1, 2, 3)
+	var indices []int64
+	var values []interface{}
+	for i, v := range ll.Backward() {
+		indices = append(indices, i)
+		values = append(values, v)
+		if v == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int64{2, 1}, indices)
+	assert.Equal(t, []interface{}{3, 2}, values)
+	ll.PushFront(0)
+	assert.Equal(t, 0, ll.Front())
+}
@@ -0,0 +1,343 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package lists
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/lists/internal/linked"
+	"reflect"
+)
+
+/** Operations **/
+
+// Splice transfers elements from the other list into the list, inserting them before the element at the specified
+// position (use End to append them). Similar to Erase, the elements transferred can be:
+//   - all the elements of the other list, when no iterators are provided,
+//   - a single element of the other list, when a single iterator is provided,
+//   - the range of elements ([first,last)) of the other list, when two iterators are provided.
+//
+// No elements are copied or re-allocated, hence transferring all the elements or a single element takes constant
+// time, whereas transferring a range is linear in the number of elements transferred (to update the sizes).
+// The other list may be the list itself when transferring a single element or a range, provided the position isn't
+// within the range. Panics if the lists contain elements of different types.
+func (ll *LinkedList) Splice(position *Iterator, other *LinkedList, iterators ...*Iterator) error {
+	if len(iterators) > 2 {
+		return fmt.Errorf("please provide a single iterator or the iterator bounds (i.e., only two iterators)")
+	}
+	if other == ll && len(iterators) == 0 {
+		return fmt.Errorf("cannot splice all the elements of a list into itself")
+	}
+	ll.checkCompatible(other)
+
+	unlock := linked.LockPair(&ll.mu, &other.mu)
+	defer unlock()
+
+	if err := ll.checkPosition(position); err != nil {
+		return err
+	}
+	for _, it := range iterators {
+		if err := other.checkPosition(it); err != nil {
+			return err
+		}
+	}
+
+	var head, tail *Node
+	var count int64
+	switch len(iterators) {
+	case 0:
+		if other.size == 0 {
+			return nil
+		}
+		// All the nodes are detached in constant time
+		head, tail, count = other.head, other.tail, other.size
+		other.head, other.tail, other.size = nil, nil, 0
+		other.modCount++
+	case 1:
+		first := iterators[0].currentNode
+		if first == nil || (other == ll && (position.currentNode == first || position.currentNode == first.next)) {
+			return nil
+		}
+		head, tail, count = other.unlinkRange(first, first.next)
+	case 2:
+		first, last := iterators[0], iterators[1]
+		if first.direction != forwardDirection || last.direction != forwardDirection {
+			return fmt.Errorf("the iterator bounds should traverse the list from the front to the back")
+		}
+		if first.currentNode == last.currentNode {
+			return nil
+		}
+		// Validate the range before detaching any element
+		for node := first.currentNode; node != last.currentNode; node = node.next {
+			if node == nil {
+				return fmt.Errorf("the last iterator is not reachable from the first iterator")
+			}
+			if other == ll && node == position.currentNode {
+				return fmt.Errorf("the position cannot be within the range being spliced")
+			}
+		}
+		head, tail, count = other.unlinkRange(first.currentNode, last.currentNode)
+	}
+	ll.linkBefore(position.currentNode, head, tail, count)
+	if other == ll && iterators[0].index < position.index {
+		// The elements were moved from before the position, hence its index is unchanged
+		position.shift(0)
+	} else {
+		position.shift(count)
+	}
+	return nil
+}
+
+// Merge merges the other list into the list, provided both the lists are sorted (see Sort). The elements are
+// transferred without being copied, leaving the other list empty. The merge is stable, i.e., for equivalent
+// elements, the elements of the list precede the elements of the other list.
+// Panics if the lists contain elements of different types.
+func (ll *LinkedList) Merge(other *LinkedList) {
+	ll.MergeFunc(other, containers.Natural)
+}
+
+// MergeFunc is similar to Merge, but the lists are ordered by the comparator instead of the Less method of the
+// elements.
+func (ll *LinkedList) MergeFunc(other *LinkedList, comparator containers.Comparator) {
+	if other == ll {
+		return
+	}
+	ll.checkCompatible(other)
+
+	unlock := linked.LockPair(&ll.mu, &other.mu)
+	defer unlock()
+
+	ll.head = linked.Merge(links, ll.head, other.head, comparator)
+	ll.size += other.size
+	ll.relink()
+	other.head, other.tail, other.size = nil, nil, 0
+	other.modCount++
+}
+
+// Sort sorts the elements of the list in place using the Less method of the elements. The sort is stable, i.e.,
+// equivalent elements retain their relative order.
+// - Time Complexity: O(n*log(n))
+// - Space Complexity: O(log(n))
+func (ll *LinkedList) Sort() {
+	ll.SortFunc(containers.Natural)
+}
+
+// SortFunc is similar to Sort, but the elements are ordered by the comparator instead of their Less method.
+func (ll *LinkedList) SortFunc(comparator containers.Comparator) {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	ll.head = linked.Sort(links, ll.head, ll.size, comparator)
+	ll.relink()
+}
+
+// Unique removes all but the first element from every consecutive group of equal elements, i.e., elements with the
+// same Key. Hence, all the duplicate elements are removed from a sorted list. Returns the number of elements removed.
+func (ll *LinkedList) Unique() int64 {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	var removed int64
+	for node := ll.head; node != nil && node.next != nil; {
+		if node.next.Value.Key() == node.Value.Key() {
+			ll.unlinkRange(node.next, node.next.next)
+			removed++
+		} else {
+			node = node.next
+		}
+	}
+	return removed
+}
+
+// Reverse reverses the order of the elements in the list.
+func (ll *LinkedList) Reverse() {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	for node := ll.head; node != nil; node = node.previous {
+		node.next, node.previous = node.previous, node.next
+	}
+	ll.head, ll.tail = ll.tail, ll.head
+	ll.modCount++
+}
+
+// Remove removes all the elements equal to the value provided, i.e., the elements with the same Key. Returns the
+// number of elements removed. Panics if an invalid type is provided.
+func (ll *LinkedList) Remove(value interface{}) int64 {
+	key := ll.valueType.Validate(value).Key()
+	return ll.removeIf(func(element containers.Container) bool {
+		return element.Key() == key
+	})
+}
+
+// RemoveIf removes all the elements for which the predicate returns true. The predicate receives the values of the
+// elements, and must not access the list. Returns the number of elements removed.
+func (ll *LinkedList) RemoveIf(predicate func(value interface{}) bool) int64 {
+	return ll.removeIf(func(element containers.Container) bool {
+		return predicate(containers.CleanBasicType(element))
+	})
+}
+
+func (ll *LinkedList) removeIf(predicate func(element containers.Container) bool) int64 {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	var removed int64
+	for node := ll.head; node != nil; {
+		next := node.next
+		if predicate(node.Value) {
+			ll.unlinkRange(node, next)
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// Resize resizes the list so that it contains size elements. If size is smaller than the current size of the list,
+// the elements at the back are removed, otherwise copies of the value provided are appended.
+// Panics if size is negative or an invalid type is provided.
+func (ll *LinkedList) Resize(size int64, value interface{}) {
+	if size < 0 {
+		panic("list size cannot be negative")
+	}
+	element := ll.valueType.Validate(value)
+
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	for ll.size > size {
+		ll.unlinkRange(ll.tail, nil)
+	}
+	for ll.size < size {
+		node := &Node{Value: element}
+		ll.linkBefore(nil, node, node, 1)
+	}
+}
+
+// Swap exchanges the elements of the list with the elements of the other list, in constant time.
+// Panics if the lists contain elements of different types.
+func (ll *LinkedList) Swap(other *LinkedList) {
+	if other == ll {
+		return
+	}
+	ll.checkCompatible(other)
+
+	unlock := linked.LockPair(&ll.mu, &other.mu)
+	defer unlock()
+
+	ll.head, other.head = other.head, ll.head
+	ll.tail, other.tail = other.tail, ll.tail
+	ll.size, other.size = other.size, ll.size
+	ll.modCount++
+	other.modCount++
+}
+
+/** Helpers **/
+
+// checkCompatible panics if the other list contains elements of a different type than the list.
+func (ll *LinkedList) checkCompatible(other *LinkedList) {
+	expected, actual := reflect.TypeOf(ll.valueType), reflect.TypeOf(other.valueType)
+	if expected != actual {
+		panic(fmt.Sprintf("lists of different types; expected: %s, received: %s", expected, actual))
+	}
+}
+
+// unlinkRange detaches the nodes in the range [first,last) from the list, where a nil last signifies the end of the
+// list. Returns the first & the last node detached along with their count. The caller must hold the write lock.
+func (ll *LinkedList) unlinkRange(first, last *Node) (*Node, *Node, int64) {
+	count, tail := int64(1), first
+	for tail.next != last {
+		tail = tail.next
+		count++
+	}
+	if first.previous != nil {
+		first.previous.next = last
+	} else {
+		ll.head = last
+	}
+	if last != nil {
+		last.previous = first.previous
+	} else {
+		ll.tail = first.previous
+	}
+	first.previous, tail.next = nil, nil
+	ll.size -= count
+	ll.modCount++
+	return first, tail, count
+}
+
+// linkBefore links the chain of nodes from head to tail before the position, where a nil position signifies the end
+// of the list. The caller must hold the write lock.
+func (ll *LinkedList) linkBefore(position *Node, head, tail *Node, count int64) {
+	if position == nil {
+		head.previous = ll.tail
+		if ll.tail != nil {
+			ll.tail.next = head
+		} else {
+			ll.head = head
+		}
+		ll.tail = tail
+	} else {
+		head.previous = position.previous
+		if position.previous != nil {
+			position.previous.next = head
+		} else {
+			ll.head = head
+		}
+		position.previous = tail
+		tail.next = position
+	}
+	ll.size += count
+	ll.modCount++
+}
+
+// relink restores the previous links & the tail of the list, after the list has been re-ordered using the next
+// links. The caller must hold the write lock.
+func (ll *LinkedList) relink() {
+	var previous *Node
+	for node := ll.head; node != nil; node = node.next {
+		node.previous = previous
+		previous = node
+	}
+	ll.tail = previous
+	ll.modCount++
+}
+
+// replace replaces the nodes of the list with the nodes of tempList. The caller must not hold the lock.
+func (ll *LinkedList) replace(tempList *LinkedList) {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	ll.head, ll.tail, ll.size = tempList.head, tempList.tail, tempList.size
+	ll.modCount++
+}
+
+// links gives the linked package access to the next links of the list's nodes
+var links = linked.Links[*Node]{
+	Next:    func(node *Node) *Node { return node.next },
+	SetNext: func(node, next *Node) { node.next = next },
+	Value:   func(node *Node) containers.Container { return node.Value },
+}
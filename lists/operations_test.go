@@ -0,0 +1,299 @@
+package lists
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+// assertLinks checks that the previous links of the list mirror its next links, and that the tail & the size of the
+// list match its nodes.
+func assertLinks(t *testing.T, ll *LinkedList) {
+	t.Helper()
+	var previous *Node
+	var size int64
+	for node := ll.head; node != nil; node = node.next {
+		assert.Equal(t, previous, node.previous)
+		previous = node
+		size++
+	}
+	assert.Equal(t, previous, ll.tail)
+	assert.Equal(t, ll.size, size)
+}
+
+func TestLinkedList_Splice(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3)
+	other := NewInt()
+	other.Insert(other.End(), 10, 20)
+	it, _ := ll.Begin().Advance(1)
+	assert.NoError(t, ll.Splice(it, other))
+	assert.Equal(t, []interface{}{1, 10, 20, 2, 3}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Empty(t, other.ToSlice())
+	assertLinks(t, other)
+
+	// Splicing into the end & from an empty list
+	other = NewInt()
+	other.Insert(other.End(), 4)
+	assert.NoError(t, ll.Splice(ll.End(), other))
+	assert.NoError(t, ll.Splice(ll.Begin(), other))
+	assert.Equal(t, []interface{}{1, 10, 20, 2, 3, 4}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	// Splicing into an empty list
+	empty := NewInt()
+	assert.NoError(t, empty.Splice(empty.Begin(), ll))
+	assert.Equal(t, []interface{}{1, 10, 20, 2, 3, 4}, empty.ToSlice())
+	assertLinks(t, empty)
+	assert.Empty(t, ll.ToSlice())
+	assertLinks(t, ll)
+}
+
+func TestLinkedList_Splice_Single(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2)
+	other := NewInt()
+	other.Insert(other.End(), 10, 20, 30)
+	it, _ := other.Begin().Advance(1)
+	assert.NoError(t, ll.Splice(ll.Begin(), other, it))
+	assert.Equal(t, []interface{}{20, 1, 2}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Equal(t, []interface{}{10, 30}, other.ToSlice())
+	assertLinks(t, other)
+
+	// Moving the last element of a list to its front
+	assert.NoError(t, ll.Splice(ll.Begin(), ll, ll.RBegin()))
+	assert.Equal(t, []interface{}{2, 20, 1}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	assert.NoError(t, ll.Splice(ll.Begin(), other, other.End()))
+	assert.Equal(t, []interface{}{2, 20, 1}, ll.ToSlice())
+	assertLinks(t, ll)
+}
+
+func TestLinkedList_Splice_Range(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2)
+	other := NewInt()
+	other.Insert(other.End(), 10, 20, 30, 40)
+	first, _ := other.Begin().Advance(1)
+	last, _ := other.Begin().Advance(3)
+	assert.NoError(t, ll.Splice(ll.End(), other, first, last))
+	assert.Equal(t, []interface{}{1, 2, 20, 30}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Equal(t, []interface{}{10, 40}, other.ToSlice())
+	assertLinks(t, other)
+
+	// The range till the end of the other list
+	assert.NoError(t, ll.Splice(ll.Begin(), other, other.Begin(), other.End()))
+	assert.Equal(t, []interface{}{10, 40, 1, 2, 20, 30}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Empty(t, other.ToSlice())
+	assertLinks(t, other)
+
+	// Moving a range within the same list
+	first, _ = ll.Begin().Advance(2)
+	assert.NoError(t, ll.Splice(ll.Begin(), ll, first, ll.End()))
+	assert.Equal(t, []interface{}{1, 2, 20, 30, 10, 40}, ll.ToSlice())
+	assertLinks(t, ll)
+}
+
+func TestLinkedList_Splice_Invalid(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1)
+	assert.Error(t, ll.Splice(ll.Begin(), ll))
+	assert.Error(t, ll.Splice(ll.Begin(), NewInt(), ll.Begin(), ll.End(), ll.End()))
+	assert.Panics(t, func() { _ = ll.Splice(ll.Begin(), NewString()) })
+}
+
+func TestLinkedList_Merge(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 3, 5, 7)
+	other := NewInt()
+	other.Insert(other.End(), 2, 3, 6, 8, 9)
+	ll.Merge(other)
+	assert.Equal(t, []interface{}{1, 2, 3, 3, 5, 6, 7, 8, 9}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Empty(t, other.ToSlice())
+	assertLinks(t, other)
+
+	ll.Merge(NewInt())
+	assert.Equal(t, int64(9), ll.Size())
+	empty := NewInt()
+	empty.Merge(ll)
+	assert.Equal(t, []interface{}{1, 2, 3, 3, 5, 6, 7, 8, 9}, empty.ToSlice())
+	assertLinks(t, empty)
+
+	assert.Panics(t, func() { empty.Merge(NewString()) })
+}
+
+func TestLinkedList_Merge_Stable(t *testing.T) {
+	type ranked struct{ Rank, Source int }
+	byRank := containers.Of(ranked{},
+		func(value interface{}) interface{} { return value },
+		func(a, b interface{}) bool {
+			return a.(ranked).Rank < b.(ranked).Rank
+		},
+	)
+	ll, other := New(byRank), New(byRank)
+	ll.PushBack(ranked{1, 1})
+	ll.PushBack(ranked{2, 1})
+	other.PushBack(ranked{1, 2})
+	other.PushBack(ranked{2, 2})
+	ll.Merge(other)
+	assert.Equal(t, []interface{}{
+		ranked{1, 1},
+		ranked{1, 2},
+		ranked{2, 1},
+		ranked{2, 2},
+	}, ll.ToSlice())
+}
+
+func TestLinkedList_Sort(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 5, 3, 9, 1, 3, 7, 2, 8, 6, 4)
+	ll.Sort()
+	assert.Equal(t, []interface{}{1, 2, 3, 3, 4, 5, 6, 7, 8, 9}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	ll.SortFunc(containers.Natural.Reverse())
+	assert.Equal(t, []interface{}{9, 8, 7, 6, 5, 4, 3, 3, 2, 1}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	empty := NewInt()
+	empty.Sort()
+	assert.Empty(t, empty.ToSlice())
+	assertLinks(t, empty)
+	single := NewInt()
+	single.Insert(single.End(), 1)
+	single.Sort()
+	assert.Equal(t, []interface{}{1}, single.ToSlice())
+	assertLinks(t, single)
+}
+
+func TestLinkedList_Sort_Stable(t *testing.T) {
+	ll := NewString()
+	for _, value := range []string{"banana", "fig", "apple", "kiwi", "plum", "cherry"} {
+		ll.PushBack(value)
+	}
+	byLength := containers.ByKey(func(c containers.Container) interface{} { return len(containers.ToString(c)) })
+	ll.SortFunc(byLength)
+	assert.Equal(t, []interface{}{"fig", "kiwi", "plum", "apple", "banana", "cherry"}, ll.ToSlice())
+	assertLinks(t, ll)
+}
+
+func TestLinkedList_Unique(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 1, 2, 2, 2, 1, 3, 3)
+	assert.Equal(t, int64(4), ll.Unique())
+	assert.Equal(t, []interface{}{1, 2, 1, 3}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	ll.Sort()
+	assert.Equal(t, int64(1), ll.Unique())
+	assert.Equal(t, []interface{}{1, 2, 3}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	assert.Equal(t, int64(0), NewInt().Unique())
+}
+
+func TestLinkedList_Reverse(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3, 4)
+	ll.Reverse()
+	assert.Equal(t, []interface{}{4, 3, 2, 1}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	empty := NewInt()
+	empty.Reverse()
+	assert.Empty(t, empty.ToSlice())
+	assertLinks(t, empty)
+}
+
+func TestLinkedList_Remove(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 1, 3, 1)
+	assert.Equal(t, int64(3), ll.Remove(1))
+	assert.Equal(t, []interface{}{2, 3}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Equal(t, int64(0), ll.Remove(5))
+	assert.Panics(t, func() { ll.Remove("one") })
+}
+
+func TestLinkedList_RemoveIf(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3, 4, 5, 6)
+	removed := ll.RemoveIf(func(value interface{}) bool { return value.(int)%2 == 0 })
+	assert.Equal(t, int64(3), removed)
+	assert.Equal(t, []interface{}{1, 3, 5}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	assert.Equal(t, int64(3), ll.RemoveIf(func(interface{}) bool { return true }))
+	assert.Empty(t, ll.ToSlice())
+	assertLinks(t, ll)
+}
+
+func TestLinkedList_Resize(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3)
+	ll.Resize(5, 0)
+	assert.Equal(t, []interface{}{1, 2, 3, 0, 0}, ll.ToSlice())
+	assertLinks(t, ll)
+	ll.Resize(2, 0)
+	assert.Equal(t, []interface{}{1, 2}, ll.ToSlice())
+	assertLinks(t, ll)
+	ll.Resize(0, 0)
+	assert.Empty(t, ll.ToSlice())
+	assertLinks(t, ll)
+
+	assert.Panics(t, func() { ll.Resize(-1, 0) })
+	assert.Panics(t, func() { ll.Resize(1, "zero") })
+}
+
+func TestLinkedList_Swap(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2)
+	other := NewInt()
+	other.Insert(other.End(), 3)
+	ll.Swap(other)
+	assert.Equal(t, []interface{}{3}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Equal(t, []interface{}{1, 2}, other.ToSlice())
+	assertLinks(t, other)
+
+	ll.Swap(ll)
+	assert.Equal(t, []interface{}{3}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Panics(t, func() { ll.Swap(NewString()) })
+}
+
+func TestLinkedList_Swap_Concurrent(t *testing.T) {
+	a := NewInt()
+	a.Insert(a.End(), 1)
+	b := NewInt()
+	b.Insert(b.End(), 2, 3)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			a.Swap(b)
+		}()
+		go func() {
+			defer wg.Done()
+			b.Swap(a)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(3), a.Size()+b.Size())
+}
+
+func TestLinkedList_Insert_End(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2)
+	ll.Insert(ll.End(), 3, 4)
+	assert.Equal(t, []interface{}{1, 2, 3, 4}, ll.ToSlice())
+	assertLinks(t, ll)
+}
@@ -0,0 +1,466 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package persistent exposes an immutable list data-structure, https://en.wikipedia.org/wiki/Persistent_data_structure.
+//
+// Every modification of a persistent list returns a new version of the list, leaving the previous versions intact.
+// The versions share structure with each other; the elements are stored in a balanced binary tree ordered by their
+// positions, hence a modification only copies the O(log(n)) nodes on the path to the modified position, and keeping
+// old versions of a list costs O(log(n)) space per modification instead of a copy of the whole list.
+package persistent
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/lists"
+	"iter"
+)
+
+// node represents an element of the list, along with the elements preceding (left) and succeeding (right) it.
+// Nodes are never modified once created, hence they can be shared by multiple versions of the list.
+type node struct {
+	value  containers.Container
+	left   *node
+	right  *node
+	size   int64
+	height int
+}
+
+// List is an immutable sequence container. The modifiers (Prepend, Append, Insert, Set & Remove) return a new version
+// of the list, sharing the unmodified elements with the list.
+//
+// As lists are never modified, all the operations on the list are thread-safe without any locking.
+type List struct {
+	root      *node
+	valueType containers.Container
+}
+
+/** Element Access **/
+
+// Get returns the value of the element at the specified index. Panics if the index is out of range.
+// - Time Complexity: O(log(n))
+func (l *List) Get(index int64) interface{} {
+	l.checkIndex(index, l.Size()-1)
+	n := l.root
+	for {
+		leftSize := sizeOf(n.left)
+		switch {
+		case index < leftSize:
+			n = n.left
+		case index > leftSize:
+			index -= leftSize + 1
+			n = n.right
+		default:
+			return containers.CleanBasicType(n.value)
+		}
+	}
+}
+
+// Front returns the value of the first element of the list, or nil if the list is empty.
+func (l *List) Front() interface{} {
+	if l.Empty() {
+		return nil
+	}
+	return l.Get(0)
+}
+
+// Back returns the value of the last element of the list, or nil if the list is empty.
+func (l *List) Back() interface{} {
+	if l.Empty() {
+		return nil
+	}
+	return l.Get(l.Size() - 1)
+}
+
+/** Iterators **/
+
+// All returns an iterator over the index-value pairs of the list from the front to the back.
+//
+//	for i, v := range list.All() {
+//		...
+//	}
+func (l *List) All() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		var index int64
+		var stack []*node
+		for n := l.root; n != nil || len(stack) > 0; n = n.right {
+			for ; n != nil; n = n.left {
+				stack = append(stack, n)
+			}
+			n, stack = stack[len(stack)-1], stack[:len(stack)-1]
+			if !yield(index, containers.CleanBasicType(n.value)) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+/** Modifiers **/
+
+// Prepend returns a new version of the list with the value inserted at the beginning of the list.
+// Panics if an invalid type is provided.
+// - Time Complexity: O(log(n))
+func (l *List) Prepend(value interface{}) *List {
+	return l.Insert(0, value)
+}
+
+// TryPrepend is similar to Prepend, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *List) TryPrepend(value interface{}) (*List, error) {
+	return l.TryInsert(0, value)
+}
+
+// Append returns a new version of the list with the value added at the end of the list.
+// Panics if an invalid type is provided.
+// - Time Complexity: O(log(n))
+func (l *List) Append(value interface{}) *List {
+	return l.Insert(l.Size(), value)
+}
+
+// TryAppend is similar to Append, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *List) TryAppend(value interface{}) (*List, error) {
+	return l.TryInsert(l.Size(), value)
+}
+
+// Insert returns a new version of the list with the value inserted before the element at the specified index, where
+// an index equal to the size of the list appends the value. Panics if an invalid type is provided, or the index is
+// out of range.
+// - Time Complexity: O(log(n))
+func (l *List) Insert(index int64, value interface{}) *List {
+	l.checkIndex(index, l.Size())
+	return l.withRoot(insert(l.root, index, l.valueType.Validate(value)))
+}
+
+// TryInsert is similar to Insert, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *List) TryInsert(index int64, value interface{}) (*List, error) {
+	l.checkIndex(index, l.Size())
+	element, err := containers.TryValidate(l.valueType, value)
+	if err != nil {
+		return nil, err
+	}
+	return l.withRoot(insert(l.root, index, element)), nil
+}
+
+// Set returns a new version of the list with the element at the specified index replaced by the value.
+// Panics if an invalid type is provided, or the index is out of range.
+// - Time Complexity: O(log(n))
+func (l *List) Set(index int64, value interface{}) *List {
+	l.checkIndex(index, l.Size()-1)
+	return l.withRoot(set(l.root, index, l.valueType.Validate(value)))
+}
+
+// TrySet is similar to Set, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *List) TrySet(index int64, value interface{}) (*List, error) {
+	l.checkIndex(index, l.Size()-1)
+	element, err := containers.TryValidate(l.valueType, value)
+	if err != nil {
+		return nil, err
+	}
+	return l.withRoot(set(l.root, index, element)), nil
+}
+
+// Remove returns a new version of the list without the element at the specified index.
+// Panics if the index is out of range.
+// - Time Complexity: O(log(n))
+func (l *List) Remove(index int64) *List {
+	l.checkIndex(index, l.Size()-1)
+	return l.withRoot(remove(l.root, index))
+}
+
+/** Capacity Functions **/
+
+// Size returns the number of elements in the list
+func (l *List) Size() int64 {
+	return sizeOf(l.root)
+}
+
+// Empty reports whether the list is empty
+func (l *List) Empty() bool {
+	return l.root == nil
+}
+
+/** Collection Functions **/
+
+// ToSlice returns the values of the list's elements from the front to the back.
+func (l *List) ToSlice() []interface{} {
+	values := make([]interface{}, 0, l.Size())
+	for _, value := range l.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing the list from the front to the back.
+func (l *List) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(l.ToSlice())
+}
+
+/** Conversions **/
+
+// ToLinkedList returns a lists.LinkedList containing the elements of the list.
+// - Time Complexity: O(n)
+func (l *List) ToLinkedList() *lists.LinkedList {
+	ll := lists.New(l.valueType)
+	for _, value := range l.All() {
+		ll.PushBack(value)
+	}
+	return ll
+}
+
+// FromLinkedList constructs a persistent list of the Container type provided, containing the elements of the linked
+// list. Panics if the linked list contains elements of an invalid type.
+// - Time Complexity: O(n)
+func FromLinkedList(valueType containers.Container, ll *lists.LinkedList) *List {
+	values := make([]containers.Container, 0, ll.Size())
+	for _, value := range ll.All() {
+		values = append(values, valueType.Validate(value))
+	}
+	return &List{root: build(values), valueType: valueType}
+}
+
+/** Helpers **/
+
+func (l *List) withRoot(root *node) *List {
+	return &List{root: root, valueType: l.valueType}
+}
+
+// checkIndex panics if the index is not in the range [0, last].
+func (l *List) checkIndex(index int64, last int64) {
+	if index < 0 || index > last {
+		panic(fmt.Sprintf("index %d out of range for list of size %d", index, l.Size()))
+	}
+}
+
+func sizeOf(n *node) int64 {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func heightOf(n *node) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func newNode(value containers.Container, left, right *node) *node {
+	return &node{
+		value:  value,
+		left:   left,
+		right:  right,
+		size:   sizeOf(left) + sizeOf(right) + 1,
+		height: max(heightOf(left), heightOf(right)) + 1,
+	}
+}
+
+// balance returns a new node of the value & the subtrees, rotating them if their heights differ by more than one.
+// For more refer: https://en.wikipedia.org/wiki/AVL_tree#Rebalancing
+func balance(value containers.Container, left, right *node) *node {
+	switch {
+	case heightOf(left) > heightOf(right)+1:
+		if heightOf(left.left) >= heightOf(left.right) {
+			return newNode(left.value, left.left, newNode(value, left.right, right))
+		}
+		return newNode(
+			left.right.value,
+			newNode(left.value, left.left, left.right.left),
+			newNode(value, left.right.right, right),
+		)
+	case heightOf(right) > heightOf(left)+1:
+		if heightOf(right.right) >= heightOf(right.left) {
+			return newNode(right.value, newNode(value, left, right.left), right.right)
+		}
+		return newNode(
+			right.left.value,
+			newNode(value, left, right.left.left),
+			newNode(right.value, right.left.right, right.right),
+		)
+	default:
+		return newNode(value, left, right)
+	}
+}
+
+// insert returns a new tree with the value inserted at the index, sharing the untouched subtrees with the tree.
+func insert(n *node, index int64, value containers.Container) *node {
+	if n == nil {
+		return newNode(value, nil, nil)
+	}
+	leftSize := sizeOf(n.left)
+	if index <= leftSize {
+		return balance(n.value, insert(n.left, index, value), n.right)
+	}
+	return balance(n.value, n.left, insert(n.right, index-leftSize-1, value))
+}
+
+// set returns a new tree with the value at the index replaced, sharing the untouched subtrees with the tree.
+func set(n *node, index int64, value containers.Container) *node {
+	leftSize := sizeOf(n.left)
+	switch {
+	case index < leftSize:
+		return newNode(n.value, set(n.left, index, value), n.right)
+	case index > leftSize:
+		return newNode(n.value, n.left, set(n.right, index-leftSize-1, value))
+	default:
+		return newNode(value, n.left, n.right)
+	}
+}
+
+// remove returns a new tree without the value at the index, sharing the untouched subtrees with the tree.
+func remove(n *node, index int64) *node {
+	leftSize := sizeOf(n.left)
+	switch {
+	case index < leftSize:
+		return balance(n.value, remove(n.left, index), n.right)
+	case index > leftSize:
+		return balance(n.value, n.left, remove(n.right, index-leftSize-1))
+	case n.left == nil:
+		return n.right
+	case n.right == nil:
+		return n.left
+	default:
+		// The element is replaced by its successor, i.e., the first element of the right subtree
+		return balance(first(n.right), n.left, remove(n.right, 0))
+	}
+}
+
+// first returns the value of the first element of the tree.
+func first(n *node) containers.Container {
+	for n.left != nil {
+		n = n.left
+	}
+	return n.value
+}
+
+// build returns a balanced tree of the values.
+func build(values []containers.Container) *node {
+	if len(values) == 0 {
+		return nil
+	}
+	middle := len(values) / 2
+	return newNode(values[middle], build(values[:middle]), build(values[middle+1:]))
+}
+
+/** Constructors **/
+
+// New constructs an empty persistent list of the Container type provided, with no elements.
+func New(valueType containers.Container) *List {
+	return &List{valueType: valueType}
+}
+
+// NewInt constructs an empty integer persistent list, with no elements.
+func NewInt() *List {
+	return New(containers.IntContainer(0))
+}
+
+// NewString constructs an empty string persistent list, with no elements.
+func NewString() *List {
+	return New(containers.StringContainer(""))
+}
+
+// NewFloat64 constructs an empty float64 persistent list, with no elements.
+func NewFloat64() *List {
+	return New(containers.Float64Container(0))
+}
+
+// NewInt64 constructs an empty int64 persistent list, with no elements.
+func NewInt64() *List {
+	return New(containers.Int64Container(0))
+}
+
+// NewUint64 constructs an empty uint64 persistent list, with no elements.
+func NewUint64() *List {
+	return New(containers.Uint64Container(0))
+}
+
+// NewInt8 constructs an empty int8 persistent list, with no elements.
+func NewInt8() *List {
+	return New(containers.Int8Container(0))
+}
+
+// NewInt16 constructs an empty int16 persistent list, with no elements.
+func NewInt16() *List {
+	return New(containers.Int16Container(0))
+}
+
+// NewInt32 constructs an empty int32 persistent list, with no elements.
+func NewInt32() *List {
+	return New(containers.Int32Container(0))
+}
+
+// NewUint constructs an empty uint persistent list, with no elements.
+func NewUint() *List {
+	return New(containers.UintContainer(0))
+}
+
+// NewUint8 constructs an empty uint8 persistent list, with no elements.
+func NewUint8() *List {
+	return New(containers.Uint8Container(0))
+}
+
+// NewUint16 constructs an empty uint16 persistent list, with no elements.
+func NewUint16() *List {
+	return New(containers.Uint16Container(0))
+}
+
+// NewUint32 constructs an empty uint32 persistent list, with no elements.
+func NewUint32() *List {
+	return New(containers.Uint32Container(0))
+}
+
+// NewFloat32 constructs an empty float32 persistent list, with no elements.
+func NewFloat32() *List {
+	return New(containers.Float32Container(0))
+}
+
+// NewRune constructs an empty rune persistent list, with no elements.
+func NewRune() *List {
+	return New(containers.RuneContainer(0))
+}
+
+// NewBool constructs an empty bool persistent list, with no elements.
+func NewBool() *List {
+	return New(containers.BoolContainer(false))
+}
+
+// NewTime constructs an empty time.Time persistent list, with no elements.
+func NewTime() *List {
+	return New(containers.TimeContainer{})
+}
+
+// NewDuration constructs an empty time.Duration persistent list, with no elements.
+func NewDuration() *List {
+	return New(containers.DurationContainer(0))
+}
+
+// NewBytes constructs an empty byte slice persistent list, with no elements.
+func NewBytes() *List {
+	return New(containers.BytesContainer{})
+}
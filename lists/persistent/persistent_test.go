@@ -0,0 +1,209 @@
+package persistent
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/lists"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+// assertBalanced asserts the sizes & heights of the nodes of the tree, and that the tree is balanced
+func assertBalanced(t *testing.T, n *node) {
+	if n == nil {
+		return
+	}
+	assert.Equal(t, sizeOf(n.left)+sizeOf(n.right)+1, n.size)
+	assert.Equal(t, max(heightOf(n.left), heightOf(n.right))+1, n.height)
+	assert.LessOrEqual(t, heightOf(n.left)-heightOf(n.right), 1)
+	assert.LessOrEqual(t, heightOf(n.right)-heightOf(n.left), 1)
+	assertBalanced(t, n.left)
+	assertBalanced(t, n.right)
+}
+
+func TestNew(t *testing.T) {
+	l := NewInt()
+	assert.True(t, l.Empty())
+	assert.Equal(t, int64(0), l.Size())
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+	assert.Empty(t, l.ToSlice())
+	assert.False(t, l.Iter().HasNext())
+
+	assert.Equal(t, containers.StringContainer(""), NewString().valueType)
+	assert.Equal(t, containers.Float64Container(0), NewFloat64().valueType)
+	assert.Equal(t, containers.Int64Container(0), NewInt64().valueType)
+	assert.Equal(t, containers.Uint64Container(0), NewUint64().valueType)
+	assert.Equal(t, containers.Int8Container(0), NewInt8().valueType)
+	assert.Equal(t, containers.Int16Container(0), NewInt16().valueType)
+	assert.Equal(t, containers.Int32Container(0), NewInt32().valueType)
+	assert.Equal(t, containers.UintContainer(0), NewUint().valueType)
+	assert.Equal(t, containers.Uint8Container(0), NewUint8().valueType)
+	assert.Equal(t, containers.Uint16Container(0), NewUint16().valueType)
+	assert.Equal(t, containers.Uint32Container(0), NewUint32().valueType)
+	assert.Equal(t, containers.Float32Container(0), NewFloat32().valueType)
+	assert.Equal(t, containers.RuneContainer(0), NewRune().valueType)
+	assert.Equal(t, containers.BoolContainer(false), NewBool().valueType)
+	assert.Equal(t, containers.TimeContainer{}, NewTime().valueType)
+	assert.Equal(t, containers.DurationContainer(0), NewDuration().valueType)
+	assert.Equal(t, containers.BytesContainer{}, NewBytes().valueType)
+}
+
+func TestList_Versions(t *testing.T) {
+	v0 := NewInt()
+	v1 := v0.Append(2)
+	v2 := v1.Prepend(1)
+	v3 := v2.Append(3)
+	v4 := v3.Set(1, 20)
+	v5 := v4.Remove(0)
+	v6 := v5.Insert(1, 10)
+
+	assert.Empty(t, v0.ToSlice())
+	assert.Equal(t, []interface{}{2}, v1.ToSlice())
+	assert.Equal(t, []interface{}{1, 2}, v2.ToSlice())
+	assert.Equal(t, []interface{}{1, 2, 3}, v3.ToSlice())
+	assert.Equal(t, []interface{}{1, 20, 3}, v4.ToSlice())
+	assert.Equal(t, []interface{}{20, 3}, v5.ToSlice())
+	assert.Equal(t, []interface{}{20, 10, 3}, v6.ToSlice())
+
+	assert.Equal(t, 1, v3.Front())
+	assert.Equal(t, 3, v3.Back())
+	assert.Equal(t, 20, v4.Get(1))
+	assert.Equal(t, 2, v3.Get(1))
+}
+
+func TestList_StructuralSharing(t *testing.T) {
+	l := NewInt()
+	for i := 0; i < 1000; i++ {
+		l = l.Append(i)
+	}
+	// Modifying the last element only copies the path to it, sharing the left subtree of the root
+	updated := l.Set(999, -1)
+	assert.Same(t, l.root.left, updated.root.left)
+	assert.NotSame(t, l.root, updated.root)
+	assert.Equal(t, 999, l.Back())
+	assert.Equal(t, -1, updated.Back())
+
+	removed := l.Remove(999)
+	assert.Same(t, l.root.left, removed.root.left)
+	assert.Equal(t, int64(1000), l.Size())
+	assert.Equal(t, int64(999), removed.Size())
+}
+
+func TestList_Invalid(t *testing.T) {
+	l := NewInt().Append(1)
+	assert.Panics(t, func() { l.Append("2") })
+	assert.Panics(t, func() { l.Prepend("2") })
+	assert.Panics(t, func() { l.Set(0, "2") })
+	assert.Panics(t, func() { l.Get(1) })
+	assert.Panics(t, func() { l.Get(-1) })
+	assert.Panics(t, func() { l.Set(1, 2) })
+	assert.Panics(t, func() { l.Remove(1) })
+	assert.Panics(t, func() { l.Insert(2, 2) })
+
+	var typeErr *containers.TypeError
+	_, err := l.TryAppend("2")
+	assert.ErrorAs(t, err, &typeErr)
+	_, err = l.TryPrepend("2")
+	assert.ErrorAs(t, err, &typeErr)
+	_, err = l.TrySet(0, "2")
+	assert.ErrorAs(t, err, &typeErr)
+	_, err = l.TryInsert(1, "2")
+	assert.ErrorAs(t, err, &typeErr)
+
+	appended, err := l.TryAppend(3)
+	assert.NoError(t, err)
+	prepended, err := appended.TryPrepend(0)
+	assert.NoError(t, err)
+	updated, err := prepended.TrySet(1, 1)
+	assert.NoError(t, err)
+	inserted, err := updated.TryInsert(2, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{0, 1, 2, 3}, inserted.ToSlice())
+	assert.Equal(t, []interface{}{1}, l.ToSlice())
+}
+
+// TestList_Random compares the versions of the list against slices, for a random sequence of operations
+func TestList_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	versions := []*List{NewInt()}
+	expected := [][]interface{}{{}}
+	for i := 0; i < 2000; i++ {
+		version := random.Intn(len(versions))
+		l, values := versions[version], append([]interface{}{}, expected[version]...)
+		size := len(values)
+		switch op := random.Intn(4); {
+		case op == 0:
+			index := random.Intn(size + 1)
+			l = l.Insert(int64(index), i)
+			values = append(values[:index], append([]interface{}{i}, values[index:]...)...)
+		case op == 1 && size > 0:
+			index := random.Intn(size)
+			l = l.Set(int64(index), i)
+			values[index] = i
+		case op == 2 && size > 0:
+			index := random.Intn(size)
+			l = l.Remove(int64(index))
+			values = append(values[:index], values[index+1:]...)
+		default:
+			l = l.Append(i)
+			values = append(values, i)
+		}
+		versions, expected = append(versions, l), append(expected, values)
+	}
+	for i, l := range versions {
+		assert.Equal(t, expected[i], l.ToSlice())
+		assert.Equal(t, int64(len(expected[i])), l.Size())
+		assertBalanced(t, l.root)
+	}
+}
+
+func TestList_All(t *testing.T) {
+	l := NewInt()
+	for i := 0; i < 10; i++ {
+		l = l.Append(i)
+	}
+	for i, v := range l.All() {
+		assert.Equal(t, int(i), v)
+		if i == 5 {
+			break
+		}
+	}
+	it := l.Iter()
+	assert.Equal(t, 0, it.Next())
+}
+
+func TestList_LinkedList(t *testing.T) {
+	ll := lists.NewInt()
+	ll.Insert(ll.End(), 1, 2, 3, 4, 5)
+	l := FromLinkedList(containers.IntContainer(0), ll)
+	assert.Equal(t, ll.ToSlice(), l.ToSlice())
+	assertBalanced(t, l.root)
+
+	// The lists are independent of each other
+	ll.PushBack(6)
+	assert.Equal(t, int64(5), l.Size())
+	converted := l.Append(7).ToLinkedList()
+	assert.Equal(t, []interface{}{1, 2, 3, 4, 5, 7}, converted.ToSlice())
+	converted.PopFront()
+	assert.Equal(t, 1, l.Front())
+
+	assert.Panics(t, func() { FromLinkedList(containers.StringContainer(""), ll) })
+	assert.True(t, FromLinkedList(containers.IntContainer(0), lists.NewInt()).Empty())
+}
+
+func TestList_Container(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	userType := containers.Of(user{},
+		func(value interface{}) interface{} { return value.(user).ID },
+		func(a, b interface{}) bool { return a.(user).ID < b.(user).ID },
+	)
+	l := New(userType).Append(user{ID: 1, Name: "John"}).Append(user{ID: 2, Name: "Jane"})
+	assert.Equal(t, user{ID: 2, Name: "Jane"}, l.Get(1))
+	ll := l.ToLinkedList()
+	assert.Equal(t, l.ToSlice(), ll.ToSlice())
+	assert.Equal(t, l.ToSlice(), FromLinkedList(userType, ll).ToSlice())
+}
@@ -0,0 +1,133 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package lists
+
+import "github.com/soheltarir/gollections/containers"
+
+/** Search Functions **/
+
+// Find returns an iterator pointing to the first element equal to the value provided, i.e., the element with the same
+// Key, or End() if the list doesn't contain the value. Panics if an invalid type is provided.
+func (ll *LinkedList) Find(value interface{}) *Iterator {
+	key := ll.valueType.Validate(value).Key()
+	return ll.find(func(element containers.Container) bool {
+		return element.Key() == key
+	})
+}
+
+// FindIf returns an iterator pointing to the first element for which the predicate returns true, or End() if there's
+// no such element. The predicate receives the values of the elements, and must not access the list.
+func (ll *LinkedList) FindIf(predicate func(value interface{}) bool) *Iterator {
+	return ll.find(func(element containers.Container) bool {
+		return predicate(containers.CleanBasicType(element))
+	})
+}
+
+// Contains reports whether the list contains an element equal to the value provided.
+// Panics if an invalid type is provided.
+func (ll *LinkedList) Contains(value interface{}) bool {
+	return ll.IndexOf(value) != -1
+}
+
+// IndexOf returns the index of the first element equal to the value provided, or -1 if the list doesn't contain the
+// value. Panics if an invalid type is provided.
+func (ll *LinkedList) IndexOf(value interface{}) int64 {
+	key := ll.valueType.Validate(value).Key()
+
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	var index int64
+	for node := ll.head; node != nil; node = node.next {
+		if node.Value.Key() == key {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last element equal to the value provided, or -1 if the list doesn't contain
+// the value. Panics if an invalid type is provided.
+func (ll *LinkedList) LastIndexOf(value interface{}) int64 {
+	key := ll.valueType.Validate(value).Key()
+
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	index := ll.size - 1
+	for node := ll.tail; node != nil; node = node.previous {
+		if node.Value.Key() == key {
+			return index
+		}
+		index--
+	}
+	return -1
+}
+
+// Count returns the number of elements equal to the value provided. Panics if an invalid type is provided.
+func (ll *LinkedList) Count(value interface{}) int64 {
+	key := ll.valueType.Validate(value).Key()
+	return ll.countIf(func(element containers.Container) bool {
+		return element.Key() == key
+	})
+}
+
+// CountIf returns the number of elements for which the predicate returns true. The predicate receives the values of
+// the elements, and must not access the list.
+func (ll *LinkedList) CountIf(predicate func(value interface{}) bool) int64 {
+	return ll.countIf(func(element containers.Container) bool {
+		return predicate(containers.CleanBasicType(element))
+	})
+}
+
+/** Helpers **/
+
+func (ll *LinkedList) find(predicate func(element containers.Container) bool) *Iterator {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	var index int64
+	for node := ll.head; node != nil; node = node.next {
+		if predicate(node.Value) {
+			return ll.iteratorAt(node, index)
+		}
+		index++
+	}
+	return ll.end
+}
+
+func (ll *LinkedList) countIf(predicate func(element containers.Container) bool) int64 {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	var count int64
+	for node := ll.head; node != nil; node = node.next {
+		if predicate(node.Value) {
+			count++
+		}
+	}
+	return count
+}
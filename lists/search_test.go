@@ -0,0 +1,83 @@
+package lists
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLinkedList_Find(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3, 2)
+	it := ll.Find(2)
+	assert.Equal(t, 2, it.Value())
+	assert.Equal(t, int64(1), it.Index())
+	assert.Equal(t, ll.End(), ll.Find(5))
+	empty := NewInt()
+	assert.Equal(t, empty.End(), empty.Find(1))
+	assert.Panics(t, func() { ll.Find("2") })
+
+	// The iterators compose with Erase & Insert
+	assert.NoError(t, ll.Erase(ll.Find(2)))
+	assert.Equal(t, []interface{}{1, 3, 2}, ll.ToSlice())
+	ll.Insert(ll.Find(3), 4)
+	assert.Equal(t, []interface{}{1, 4, 3, 2}, ll.ToSlice())
+	ll.Insert(ll.Find(5), 5)
+	assert.Equal(t, []interface{}{1, 4, 3, 2, 5}, ll.ToSlice())
+}
+
+func TestLinkedList_FindIf(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3, 4)
+	it := ll.FindIf(func(value interface{}) bool { return value.(int) > 2 })
+	assert.Equal(t, 3, it.Value())
+	assert.Equal(t, int64(2), it.Index())
+	assert.Equal(t, ll.End(), ll.FindIf(func(value interface{}) bool { return value.(int) > 4 }))
+}
+
+func TestLinkedList_FindByKey(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	ll := New(containers.Of(user{},
+		func(value interface{}) interface{} { return value.(user).ID },
+		func(a, b interface{}) bool { return a.(user).ID < b.(user).ID },
+	))
+	ll.PushBack(user{ID: 1, Name: "John"})
+	ll.PushBack(user{ID: 2, Name: "Jane"})
+
+	assert.Equal(t, user{ID: 2, Name: "Jane"}, ll.Find(user{ID: 2}).Value())
+	assert.True(t, ll.Contains(user{ID: 1, Name: "Someone else"}))
+	assert.Equal(t, int64(1), ll.IndexOf(user{ID: 2}))
+}
+
+func TestLinkedList_Contains(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3)
+	assert.True(t, ll.Contains(2))
+	assert.False(t, ll.Contains(4))
+	assert.False(t, NewInt().Contains(1))
+}
+
+func TestLinkedList_IndexOf(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3, 2, 1)
+	assert.Equal(t, int64(1), ll.IndexOf(2))
+	assert.Equal(t, int64(3), ll.LastIndexOf(2))
+	assert.Equal(t, int64(0), ll.IndexOf(1))
+	assert.Equal(t, int64(4), ll.LastIndexOf(1))
+	assert.Equal(t, int64(2), ll.LastIndexOf(3))
+	assert.Equal(t, int64(-1), ll.IndexOf(5))
+	assert.Equal(t, int64(-1), ll.LastIndexOf(5))
+	assert.Equal(t, 2, ll.At(ll.LastIndexOf(2)).Value())
+}
+
+func TestLinkedList_Count(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3, 2, 1)
+	assert.Equal(t, int64(2), ll.Count(2))
+	assert.Equal(t, int64(0), ll.Count(5))
+	assert.Equal(t, int64(4), ll.CountIf(func(value interface{}) bool { return value.(int) < 3 }))
+	assert.Equal(t, int64(0), NewInt().CountIf(func(value interface{}) bool { return true }))
+}
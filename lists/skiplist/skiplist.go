@@ -0,0 +1,580 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package skiplist exposes an ordered map data-structure implemented as a skip list,
+// https://en.wikipedia.org/wiki/Skip_list.
+//
+// A skip list is a hierarchy of sorted linked lists; every element is linked in the bottom level, and each level
+// links a random subset (of the configured probability) of the elements of the level below it, allowing the
+// insert, search & delete operations to skip over most of the elements in expected O(log(n)) time.
+// Unlike balanced trees, every modification is local to the levels of a single element, hence the concurrent variant
+// of the list locks each level independently instead of the whole list.
+package skiplist
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"iter"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// DefaultProbability is the default probability of an element being linked in the next level of the list
+	DefaultProbability = 0.25
+	// DefaultMaxLevel is the default maximum number of levels of the list, which suits up to 4^16 elements with the
+	// default probability
+	DefaultMaxLevel = 16
+	// maxLevelLimit is the maximum number of levels a list can be configured with
+	maxLevelLimit = 64
+)
+
+// Config configures a skip list. The zero value of every field selects its default.
+type Config struct {
+	// Comparator orders the keys of the list, defaults to containers.Natural
+	Comparator containers.Comparator
+	// Probability of an element being linked in the next level of the list, in the range (0, 1). Lower probabilities
+	// use less memory, at the cost of longer searches. Defaults to DefaultProbability.
+	Probability float64
+	// MaxLevel is the maximum number of levels of the list, in the range [1, 64]. Defaults to DefaultMaxLevel.
+	MaxLevel int
+	// Concurrent selects locking each level of the list independently instead of the whole list, allowing
+	// operations on different levels to proceed in parallel.
+	Concurrent bool
+}
+
+// node represents an element in a skip list, linked in the levels below len(next)
+type node struct {
+	key   containers.Container
+	value interface{}
+	next  []*node
+}
+
+// SkipList is an ordered map of keys to values. The keys are Containers ordered by their Less method (or the
+// comparator provided), and two keys are considered equal when neither is less than the other.
+//
+// All the operations on the list are thread-safe.
+type SkipList struct {
+	// head is a sentinel node preceding the first element in every level
+	head        *node
+	level       atomic.Int32
+	size        atomic.Int64
+	keyType     containers.Container
+	comparator  containers.Comparator
+	probability float64
+	maxLevel    int
+	// mu guards the whole list, unless the list is concurrent
+	mu sync.RWMutex
+	// levels guard the links of each level of a concurrent list
+	levels     []sync.RWMutex
+	concurrent bool
+}
+
+/** Element Access **/
+
+// Get returns the value of the key, and whether the list contains the key. Panics if an invalid type is provided.
+// - Time Complexity: O(log(n)) expected
+func (l *SkipList) Get(key interface{}) (interface{}, bool) {
+	element := l.keyType.Validate(key)
+	preds := make([]*node, l.maxLevel)
+
+	l.rlock()
+	defer l.runlock()
+	defer l.descend(element, 0, preds)()
+
+	if next := preds[0].next[0]; next != nil && !l.comparator(element, next.key) {
+		return next.value, true
+	}
+	return nil, false
+}
+
+// Contains reports whether the list contains the key. Panics if an invalid type is provided.
+func (l *SkipList) Contains(key interface{}) bool {
+	_, ok := l.Get(key)
+	return ok
+}
+
+// Floor returns the greatest key (and its value) less than or equal to the key provided, and whether such a key
+// exists. Panics if an invalid type is provided.
+// - Time Complexity: O(log(n)) expected
+func (l *SkipList) Floor(key interface{}) (interface{}, interface{}, bool) {
+	element := l.keyType.Validate(key)
+	preds := make([]*node, l.maxLevel)
+
+	l.rlock()
+	defer l.runlock()
+	defer l.descend(element, 0, preds)()
+
+	found := preds[0]
+	if next := found.next[0]; next != nil && !l.comparator(element, next.key) {
+		found = next
+	}
+	if found == l.head {
+		return nil, nil, false
+	}
+	return containers.CleanBasicType(found.key), found.value, true
+}
+
+// Ceiling returns the least key (and its value) greater than or equal to the key provided, and whether such a key
+// exists. Panics if an invalid type is provided.
+// - Time Complexity: O(log(n)) expected
+func (l *SkipList) Ceiling(key interface{}) (interface{}, interface{}, bool) {
+	element := l.keyType.Validate(key)
+	preds := make([]*node, l.maxLevel)
+
+	l.rlock()
+	defer l.runlock()
+	defer l.descend(element, 0, preds)()
+
+	found := preds[0].next[0]
+	if found == nil {
+		return nil, nil, false
+	}
+	return containers.CleanBasicType(found.key), found.value, true
+}
+
+/** Iterators **/
+
+// All returns an iterator over the key-value pairs of the list in the ascending order of the keys.
+// The bottom level of the list is read locked during the iteration, hence the loop body must not modify the list.
+//
+//	for key, value := range list.All() {
+//		...
+//	}
+func (l *SkipList) All() iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		l.rlock()
+		defer l.runlock()
+		l.lockLevel(0, false)
+		defer l.unlockLevel(0, false)
+
+		l.yieldFrom(l.head.next[0], nil, yield)
+	}
+}
+
+// Range returns an iterator over the key-value pairs of the list with keys in the range [from, to), in the
+// ascending order of the keys. A nil bound leaves the range unbounded on that side. The locking semantics are the
+// same as All. Panics if an invalid type is provided.
+func (l *SkipList) Range(from, to interface{}) iter.Seq2[interface{}, interface{}] {
+	var lower, upper containers.Container
+	if from != nil {
+		lower = l.keyType.Validate(from)
+	}
+	if to != nil {
+		upper = l.keyType.Validate(to)
+	}
+	return func(yield func(interface{}, interface{}) bool) {
+		l.rlock()
+		defer l.runlock()
+
+		start := l.head
+		if lower != nil {
+			preds := make([]*node, l.maxLevel)
+			defer l.descend(lower, 0, preds)()
+			start = preds[0]
+		} else {
+			l.lockLevel(0, false)
+			defer l.unlockLevel(0, false)
+		}
+		l.yieldFrom(start.next[0], upper, yield)
+	}
+}
+
+// yieldFrom yields the key-value pairs of the bottom level from the node provided, up to (excluding) the upper
+// bound. The caller must hold the lock of the bottom level.
+func (l *SkipList) yieldFrom(start *node, upper containers.Container, yield func(interface{}, interface{}) bool) {
+	for n := start; n != nil; n = n.next[0] {
+		if upper != nil && !l.comparator(n.key, upper) {
+			return
+		}
+		if !yield(containers.CleanBasicType(n.key), n.value) {
+			return
+		}
+	}
+}
+
+/** Modifiers **/
+
+// Insert associates the value with the key, replacing the value of an existing equal key. Returns whether the key
+// was newly added. Panics if an invalid type is provided.
+// - Time Complexity: O(log(n)) expected
+func (l *SkipList) Insert(key, value interface{}) bool {
+	return l.insert(l.keyType.Validate(key), value)
+}
+
+// TryInsert is similar to Insert, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *SkipList) TryInsert(key, value interface{}) (bool, error) {
+	element, err := containers.TryValidate(l.keyType, key)
+	if err != nil {
+		return false, err
+	}
+	return l.insert(element, value), nil
+}
+
+func (l *SkipList) insert(element containers.Container, value interface{}) bool {
+	height := l.randomLevel()
+	preds := make([]*node, l.maxLevel)
+
+	l.lock()
+	defer l.unlock()
+	defer l.descend(element, height, preds)()
+
+	if next := preds[0].next[0]; next != nil && !l.comparator(element, next.key) {
+		next.value = value
+		return false
+	}
+	n := &node{key: element, value: value, next: make([]*node, height)}
+	for i := 0; i < height; i++ {
+		n.next[i] = preds[i].next[i]
+		preds[i].next[i] = n
+	}
+	for level := l.level.Load(); int32(height) > level; level = l.level.Load() {
+		if l.level.CompareAndSwap(level, int32(height)) {
+			break
+		}
+	}
+	l.size.Add(1)
+	return true
+}
+
+// Delete removes the key (and its value) from the list, and returns whether the list contained the key.
+// Panics if an invalid type is provided.
+// - Time Complexity: O(log(n)) expected
+func (l *SkipList) Delete(key interface{}) bool {
+	element := l.keyType.Validate(key)
+	preds := make([]*node, l.maxLevel)
+
+	l.lock()
+	defer l.unlock()
+
+	for {
+		// The levels to be write locked are only known once the element is found
+		release := l.descend(element, 0, preds)
+		target := preds[0].next[0]
+		release()
+		if target == nil || l.comparator(element, target.key) {
+			return false
+		}
+		if l.unlink(element, target, preds) {
+			return true
+		}
+		// The element was removed or replaced in between, hence the search is retried
+	}
+}
+
+// unlink removes the target node from every level it is linked in, provided it's still linked in the list.
+func (l *SkipList) unlink(element containers.Container, target *node, preds []*node) bool {
+	height := len(target.next)
+	defer l.descend(element, height, preds)()
+
+	if preds[0].next[0] != target {
+		return false
+	}
+	for i := 0; i < height; i++ {
+		preds[i].next[i] = target.next[i]
+	}
+	l.size.Add(-1)
+	return true
+}
+
+// Clear removes all the elements from the list.
+func (l *SkipList) Clear() {
+	l.lock()
+	defer l.unlock()
+	for i := l.maxLevel - 1; i >= 0; i-- {
+		l.lockLevel(i, true)
+	}
+	defer func() {
+		for i := 0; i < l.maxLevel; i++ {
+			l.unlockLevel(i, true)
+		}
+	}()
+
+	clear(l.head.next)
+	l.level.Store(1)
+	l.size.Store(0)
+}
+
+/** Capacity Functions **/
+
+// Size returns the number of elements in the list
+func (l *SkipList) Size() int64 {
+	return l.size.Load()
+}
+
+// Empty reports whether the list is empty
+func (l *SkipList) Empty() bool {
+	return l.Size() == 0
+}
+
+/** Collection Functions **/
+
+// ToSlice returns the keys of the list in the ascending order.
+func (l *SkipList) ToSlice() []interface{} {
+	keys := make([]interface{}, 0, l.Size())
+	for key := range l.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the keys of the list in the ascending order.
+func (l *SkipList) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(l.ToSlice())
+}
+
+/** Helpers **/
+
+// descend walks the list from its top level down to the bottom level, recording in preds the last node preceding
+// the element in each level. The levels below writeLevels are write locked, while the others are read locked only
+// until the level below them is locked. Returns the function releasing the locks still held, i.e., the write locked
+// levels, or the read locked bottom level if no level is write locked.
+//
+// Every node is linked in the levels below the levels it's linked in, & linking or unlinking a node in a level
+// requires the write lock of the level, hence holding a level's lock while locking the level below it guarantees
+// the predecessor found in a level is still linked in the level below.
+func (l *SkipList) descend(element containers.Container, writeLevels int, preds []*node) func() {
+	top := max(int(l.level.Load()), writeLevels) - 1
+	x := l.head
+	for i := top; i >= 0; i-- {
+		l.lockLevel(i, i < writeLevels)
+		if i < top && i+1 >= writeLevels {
+			l.unlockLevel(i+1, false)
+		}
+		for next := x.next[i]; next != nil && l.comparator(next.key, element); next = x.next[i] {
+			x = next
+		}
+		preds[i] = x
+	}
+	return func() {
+		if writeLevels == 0 {
+			l.unlockLevel(0, false)
+		}
+		for i := 0; i < writeLevels; i++ {
+			l.unlockLevel(i, true)
+		}
+	}
+}
+
+// randomLevel returns the number of levels a new element is linked in.
+func (l *SkipList) randomLevel() int {
+	level := 1
+	for level < l.maxLevel && rand.Float64() < l.probability {
+		level++
+	}
+	return level
+}
+
+// lock write locks the whole list, unless the list is concurrent.
+func (l *SkipList) lock() {
+	if !l.concurrent {
+		l.mu.Lock()
+	}
+}
+
+func (l *SkipList) unlock() {
+	if !l.concurrent {
+		l.mu.Unlock()
+	}
+}
+
+// rlock read locks the whole list, unless the list is concurrent.
+func (l *SkipList) rlock() {
+	if !l.concurrent {
+		l.mu.RLock()
+	}
+}
+
+func (l *SkipList) runlock() {
+	if !l.concurrent {
+		l.mu.RUnlock()
+	}
+}
+
+// lockLevel locks a level of a concurrent list, either for writing or for reading.
+func (l *SkipList) lockLevel(level int, write bool) {
+	switch {
+	case !l.concurrent:
+	case write:
+		l.levels[level].Lock()
+	default:
+		l.levels[level].RLock()
+	}
+}
+
+func (l *SkipList) unlockLevel(level int, write bool) {
+	switch {
+	case !l.concurrent:
+	case write:
+		l.levels[level].Unlock()
+	default:
+		l.levels[level].RUnlock()
+	}
+}
+
+/** Constructors **/
+
+// New constructs an empty skip list with keys of the Container type provided, ordered by their Less method.
+func New(keyType containers.Container) *SkipList {
+	return NewWithConfig(keyType, Config{})
+}
+
+// NewFunc constructs an empty skip list with keys of the Container type provided, ordered by the comparator instead
+// of their Less method.
+func NewFunc(keyType containers.Container, comparator containers.Comparator) *SkipList {
+	return NewWithConfig(keyType, Config{Comparator: comparator})
+}
+
+// NewConcurrent constructs an empty concurrent skip list (see Config.Concurrent) with keys of the Container type
+// provided, ordered by their Less method.
+func NewConcurrent(keyType containers.Container) *SkipList {
+	return NewWithConfig(keyType, Config{Concurrent: true})
+}
+
+// NewWithConfig constructs an empty skip list with keys of the Container type provided, configured by the config.
+// Panics if the probability or the maximum level are out of range.
+func NewWithConfig(keyType containers.Container, config Config) *SkipList {
+	if config.Comparator == nil {
+		config.Comparator = containers.Natural
+	}
+	if config.Probability == 0 {
+		config.Probability = DefaultProbability
+	}
+	if config.MaxLevel == 0 {
+		config.MaxLevel = DefaultMaxLevel
+	}
+	if config.Probability <= 0 || config.Probability >= 1 {
+		panic(fmt.Sprintf("skip list probability should be in the range (0, 1), received: %v", config.Probability))
+	}
+	if config.MaxLevel < 1 || config.MaxLevel > maxLevelLimit {
+		panic(fmt.Sprintf("skip list max level should be in the range [1, %d], received: %d",
+			maxLevelLimit, config.MaxLevel))
+	}
+	l := &SkipList{
+		head:        &node{next: make([]*node, config.MaxLevel)},
+		keyType:     keyType,
+		comparator:  config.Comparator,
+		probability: config.Probability,
+		maxLevel:    config.MaxLevel,
+		concurrent:  config.Concurrent,
+	}
+	if config.Concurrent {
+		l.levels = make([]sync.RWMutex, config.MaxLevel)
+	}
+	l.level.Store(1)
+	return l
+}
+
+// NewInt constructs an empty skip list with int keys.
+func NewInt() *SkipList {
+	return New(containers.IntContainer(0))
+}
+
+// NewString constructs an empty skip list with string keys.
+func NewString() *SkipList {
+	return New(containers.StringContainer(""))
+}
+
+// NewFloat64 constructs an empty skip list with float64 keys.
+func NewFloat64() *SkipList {
+	return New(containers.Float64Container(0))
+}
+
+// NewInt64 constructs an empty skip list with int64 keys.
+func NewInt64() *SkipList {
+	return New(containers.Int64Container(0))
+}
+
+// NewUint64 constructs an empty skip list with uint64 keys.
+func NewUint64() *SkipList {
+	return New(containers.Uint64Container(0))
+}
+
+// NewInt8 constructs an empty skip list with int8 keys.
+func NewInt8() *SkipList {
+	return New(containers.Int8Container(0))
+}
+
+// NewInt16 constructs an empty skip list with int16 keys.
+func NewInt16() *SkipList {
+	return New(containers.Int16Container(0))
+}
+
+// NewInt32 constructs an empty skip list with int32 keys.
+func NewInt32() *SkipList {
+	return New(containers.Int32Container(0))
+}
+
+// NewUint constructs an empty skip list with uint keys.
+func NewUint() *SkipList {
+	return New(containers.UintContainer(0))
+}
+
+// NewUint8 constructs an empty skip list with uint8 keys.
+func NewUint8() *SkipList {
+	return New(containers.Uint8Container(0))
+}
+
+// NewUint16 constructs an empty skip list with uint16 keys.
+func NewUint16() *SkipList {
+	return New(containers.Uint16Container(0))
+}
+
+// NewUint32 constructs an empty skip list with uint32 keys.
+func NewUint32() *SkipList {
+	return New(containers.Uint32Container(0))
+}
+
+// NewFloat32 constructs an empty skip list with float32 keys.
+func NewFloat32() *SkipList {
+	return New(containers.Float32Container(0))
+}
+
+// NewRune constructs an empty skip list with rune keys.
+func NewRune() *SkipList {
+	return New(containers.RuneContainer(0))
+}
+
+// NewBool constructs an empty skip list with bool keys.
+func NewBool() *SkipList {
+	return New(containers.BoolContainer(false))
+}
+
+// NewTime constructs an empty skip list with time.Time keys.
+func NewTime() *SkipList {
+	return New(containers.TimeContainer{})
+}
+
+// NewDuration constructs an empty skip list with time.Duration keys.
+func NewDuration() *SkipList {
+	return New(containers.DurationContainer(0))
+}
+
+// NewBytes constructs an empty skip list with []byte keys.
+func NewBytes() *SkipList {
+	return New(containers.BytesContainer{})
+}
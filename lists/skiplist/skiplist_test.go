@@ -0,0 +1,259 @@
+package skiplist
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// assertConsistent asserts every level of the list is sorted, and only links the elements of the level below it
+func assertConsistent(t *testing.T, l *SkipList) {
+	var below map[*node]bool
+	for i := 0; i < l.maxLevel; i++ {
+		linked := make(map[*node]bool)
+		var previous *node
+		for n := l.head.next[i]; n != nil; n = n.next[i] {
+			if previous != nil {
+				assert.True(t, l.comparator(previous.key, n.key), "level %d isn't sorted", i)
+			}
+			if below != nil {
+				assert.True(t, below[n], "level %d links an element missing in the level below", i)
+			}
+			assert.Greater(t, len(n.next), i)
+			linked[n] = true
+			previous = n
+		}
+		if i == 0 {
+			assert.Equal(t, int64(len(linked)), l.Size())
+		}
+		if i >= int(l.level.Load()) {
+			assert.Empty(t, linked, "level %d is above the level of the list", i)
+		}
+		below = linked
+	}
+}
+
+func TestNew(t *testing.T) {
+	l := NewInt()
+	assert.True(t, l.Empty())
+	assert.Equal(t, DefaultMaxLevel, l.maxLevel)
+	assert.Equal(t, DefaultProbability, l.probability)
+	assert.False(t, l.concurrent)
+	assert.True(t, NewConcurrent(containers.IntContainer(0)).concurrent)
+
+	assert.Equal(t, containers.StringContainer(""), NewString().keyType)
+	assert.Equal(t, containers.Float64Container(0), NewFloat64().keyType)
+	assert.Equal(t, containers.Int64Container(0), NewInt64().keyType)
+	assert.Equal(t, containers.Uint64Container(0), NewUint64().keyType)
+	assert.Equal(t, containers.Int8Container(0), NewInt8().keyType)
+	assert.Equal(t, containers.Int16Container(0), NewInt16().keyType)
+	assert.Equal(t, containers.Int32Container(0), NewInt32().keyType)
+	assert.Equal(t, containers.UintContainer(0), NewUint().keyType)
+	assert.Equal(t, containers.Uint8Container(0), NewUint8().keyType)
+	assert.Equal(t, containers.Uint16Container(0), NewUint16().keyType)
+	assert.Equal(t, containers.Uint32Container(0), NewUint32().keyType)
+	assert.Equal(t, containers.Float32Container(0), NewFloat32().keyType)
+	assert.Equal(t, containers.RuneContainer(0), NewRune().keyType)
+	assert.Equal(t, containers.BoolContainer(false), NewBool().keyType)
+	assert.Equal(t, containers.TimeContainer{}, NewTime().keyType)
+	assert.Equal(t, containers.DurationContainer(0), NewDuration().keyType)
+	assert.Equal(t, containers.BytesContainer{}, NewBytes().keyType)
+
+	assert.Panics(t, func() { NewWithConfig(containers.IntContainer(0), Config{Probability: 1}) })
+	assert.Panics(t, func() { NewWithConfig(containers.IntContainer(0), Config{Probability: -0.5}) })
+	assert.Panics(t, func() { NewWithConfig(containers.IntContainer(0), Config{MaxLevel: 65}) })
+	assert.Panics(t, func() { NewWithConfig(containers.IntContainer(0), Config{MaxLevel: -1}) })
+}
+
+func TestSkipList_Insert(t *testing.T) {
+	for name, l := range map[string]*SkipList{"default": NewInt(), "concurrent": NewConcurrent(containers.IntContainer(0))} {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range rand.Perm(200) {
+				assert.True(t, l.Insert(key, key*10))
+			}
+			assert.False(t, l.Insert(5, "five"))
+			assert.Equal(t, int64(200), l.Size())
+			assertConsistent(t, l)
+
+			value, ok := l.Get(5)
+			assert.True(t, ok)
+			assert.Equal(t, "five", value)
+			value, ok = l.Get(150)
+			assert.True(t, ok)
+			assert.Equal(t, 1500, value)
+			_, ok = l.Get(200)
+			assert.False(t, ok)
+			assert.True(t, l.Contains(0))
+			assert.False(t, l.Contains(-1))
+
+			keys := l.ToSlice()
+			assert.Len(t, keys, 200)
+			assert.True(t, sort.SliceIsSorted(keys, func(i, j int) bool { return keys[i].(int) < keys[j].(int) }))
+
+			assert.Panics(t, func() { l.Insert("1", 1) })
+			assert.Panics(t, func() { l.Get("1") })
+			var typeErr *containers.TypeError
+			_, err := l.TryInsert("1", 1)
+			assert.ErrorAs(t, err, &typeErr)
+			added, err := l.TryInsert(200, nil)
+			assert.NoError(t, err)
+			assert.True(t, added)
+		})
+	}
+}
+
+func TestSkipList_Delete(t *testing.T) {
+	for name, l := range map[string]*SkipList{"default": NewInt(), "concurrent": NewConcurrent(containers.IntContainer(0))} {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				l.Insert(i, nil)
+			}
+			for i := 0; i < 100; i += 2 {
+				assert.True(t, l.Delete(i))
+			}
+			assert.False(t, l.Delete(0))
+			assert.False(t, l.Delete(100))
+			assert.Equal(t, int64(50), l.Size())
+			assertConsistent(t, l)
+			for i := 0; i < 100; i++ {
+				assert.Equal(t, i%2 == 1, l.Contains(i))
+			}
+			assert.Panics(t, func() { l.Delete("1") })
+
+			l.Clear()
+			assert.True(t, l.Empty())
+			assert.Empty(t, l.ToSlice())
+			assertConsistent(t, l)
+			l.Insert(1, nil)
+			assert.Equal(t, []interface{}{1}, l.ToSlice())
+		})
+	}
+}
+
+func TestSkipList_FloorCeiling(t *testing.T) {
+	l := NewInt()
+	for _, key := range []int{10, 20, 30} {
+		l.Insert(key, key/10)
+	}
+	tests := []struct {
+		key                  int
+		floor, ceiling       interface{}
+		hasFloor, hasCeiling bool
+	}{
+		{key: 5, ceiling: 10, hasCeiling: true},
+		{key: 10, floor: 10, ceiling: 10, hasFloor: true, hasCeiling: true},
+		{key: 15, floor: 10, ceiling: 20, hasFloor: true, hasCeiling: true},
+		{key: 30, floor: 30, ceiling: 30, hasFloor: true, hasCeiling: true},
+		{key: 35, floor: 30, hasFloor: true},
+	}
+	for _, test := range tests {
+		key, _, ok := l.Floor(test.key)
+		assert.Equal(t, test.hasFloor, ok, test.key)
+		assert.Equal(t, test.floor, key, test.key)
+		key, _, ok = l.Ceiling(test.key)
+		assert.Equal(t, test.hasCeiling, ok, test.key)
+		assert.Equal(t, test.ceiling, key, test.key)
+	}
+	_, value, _ := l.Floor(25)
+	assert.Equal(t, 2, value)
+	_, value, _ = l.Ceiling(25)
+	assert.Equal(t, 3, value)
+
+	_, _, ok := NewInt().Floor(1)
+	assert.False(t, ok)
+	_, _, ok = NewInt().Ceiling(1)
+	assert.False(t, ok)
+}
+
+func TestSkipList_Range(t *testing.T) {
+	l := NewInt()
+	for i := 1; i <= 10; i++ {
+		l.Insert(i, i*i)
+	}
+	collect := func(from, to interface{}) []interface{} {
+		var keys []interface{}
+		for key, value := range l.Range(from, to) {
+			assert.Equal(t, key.(int)*key.(int), value)
+			keys = append(keys, key)
+		}
+		return keys
+	}
+	assert.Equal(t, []interface{}{3, 4, 5}, collect(3, 6))
+	assert.Equal(t, []interface{}{1, 2}, collect(nil, 3))
+	assert.Equal(t, []interface{}{9, 10}, collect(9, nil))
+	assert.Len(t, collect(nil, nil), 10)
+	assert.Empty(t, collect(6, 3))
+	assert.Empty(t, collect(11, nil))
+	assert.Panics(t, func() { l.Range("1", nil) })
+
+	var keys []interface{}
+	for key := range l.All() {
+		keys = append(keys, key)
+		if len(keys) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []interface{}{1, 2, 3}, keys)
+
+	it := l.Iter()
+	assert.Equal(t, 1, it.Next())
+}
+
+func TestSkipList_Comparator(t *testing.T) {
+	l := NewWithConfig(containers.IntContainer(0), Config{
+		Comparator:  containers.Natural.Reverse(),
+		Probability: 0.5,
+		MaxLevel:    4,
+	})
+	for i := 0; i < 100; i++ {
+		l.Insert(i, nil)
+	}
+	assertConsistent(t, l)
+	assert.Equal(t, []interface{}{99, 98, 97}, l.ToSlice()[:3])
+	key, _, _ := l.Floor(50)
+	assert.Equal(t, 50, key)
+	key, _, _ = l.Ceiling(-1)
+	assert.Nil(t, key)
+
+	reversed := NewFunc(containers.IntContainer(0), containers.Natural.Reverse())
+	reversed.Insert(1, nil)
+	reversed.Insert(2, nil)
+	assert.Equal(t, []interface{}{2, 1}, reversed.ToSlice())
+}
+
+func TestSkipList_Concurrency(t *testing.T) {
+	for name, l := range map[string]*SkipList{"default": NewInt(), "concurrent": NewConcurrent(containers.IntContainer(0))} {
+		t.Run(name, func(t *testing.T) {
+			const workers, keys = 8, 200
+			var wg sync.WaitGroup
+			for w := 0; w < workers; w++ {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					for i := 0; i < keys; i++ {
+						key := w*keys + i
+						l.Insert(key, w)
+						l.Insert(-key-1, w)
+						assert.True(t, l.Contains(key))
+						l.Floor(key)
+						l.Ceiling(-key)
+						assert.True(t, l.Delete(-key-1))
+						if i%50 == 0 {
+							for range l.Range(key-10, key) {
+							}
+						}
+					}
+				}(w)
+			}
+			wg.Wait()
+			assert.Equal(t, int64(workers*keys), l.Size())
+			assertConsistent(t, l)
+			for key, value := range l.All() {
+				assert.Equal(t, key.(int)/keys, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package lists
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/snapshot"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLinkedList_Snapshot(t *testing.T) {
+	ll := NewString()
+	ll.PushBack("a")
+	ll.PushBack("b")
+	ll.PushBack("c")
+	var buf bytes.Buffer
+	assert.NoError(t, ll.Snapshot(&buf))
+
+	restored := NewString()
+	restored.PushBack("stale")
+	assert.NoError(t, restored.Restore(&buf))
+	assert.Equal(t, []interface{}{"a", "b", "c"}, restored.ToSlice())
+	assert.Equal(t, "c", restored.Back())
+	assert.Equal(t, int64(3), restored.Size())
+}
+
+func TestLinkedList_Restore_Truncated(t *testing.T) {
+	ll := NewInt()
+	for i := 0; i < 100; i++ {
+		ll.PushBack(i)
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, ll.Snapshot(&buf))
+	data := buf.Bytes()
+
+	restored := NewInt()
+	restored.PushBack(-1)
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(data[:len(data)-1])), snapshot.ErrTruncated)
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(data[:len(data)/2])), snapshot.ErrTruncated)
+	// The list is left unmodified
+	assert.Equal(t, []interface{}{-1}, restored.ToSlice())
+
+	var zero LinkedList
+	assert.ErrorIs(t, zero.Restore(bytes.NewReader(data)), containers.ErrNoValueType)
+}
+
+func TestLinkedList_Gob(t *testing.T) {
+	type checkpoint struct {
+		Name  string
+		Items *LinkedList
+	}
+	ll := NewTime()
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(checkpoint{Name: "empty", Items: ll}))
+
+	decoded := checkpoint{Items: NewTime()}
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, "empty", decoded.Name)
+	assert.True(t, decoded.Items.Empty())
+
+	floats := NewFloat64()
+	floats.PushBack(1.5)
+	floats.PushBack(2.5)
+	buf.Reset()
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(floats))
+	restored := NewFloat64()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(restored))
+	assert.Equal(t, []interface{}{1.5, 2.5}, restored.ToSlice())
+}
@@ -0,0 +1,240 @@
+package lists
+
+import (
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+// assertConsistent checks the links of the list against its size.
+func assertConsistent(t *testing.T, ll *LinkedList) {
+	t.Helper()
+	var forward, backward int64
+	var previous *Node
+	for node := ll.head; node != nil; node = node.next {
+		assert.Equal(t, previous, node.previous)
+		previous = node
+		forward++
+	}
+	assert.Equal(t, previous, ll.tail)
+	for node := ll.tail; node != nil; node = node.previous {
+		backward++
+	}
+	assert.Equal(t, ll.Size(), forward)
+	assert.Equal(t, ll.Size(), backward)
+}
+
+// traverse iterates the list using its stateful iterators, recovering from concurrent modifications.
+func traverse(t *testing.T, ll *LinkedList) {
+	defer func() {
+		if r := recover(); r != nil {
+			assert.Equal(t, containers.ErrConcurrentModification, r)
+		}
+	}()
+	for it := ll.Begin(); it != ll.End(); it = it.Next() {
+		_ = it.Value()
+	}
+}
+
+// drain exhausts the gollections.Iterator, recovering from concurrent modifications.
+func drain(t *testing.T, it gollections.Iterator) {
+	defer func() {
+		if r := recover(); r != nil {
+			assert.Equal(t, containers.ErrConcurrentModification, r)
+		}
+	}()
+	for it.HasNext() {
+		it.Next()
+	}
+}
+
+func TestLinkedList_Stress(t *testing.T) {
+	const workers, operations = 8, 1000
+	ll := NewInt()
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < operations; i++ {
+				switch (worker + i) % 9 {
+				case 0:
+					ll.PushBack(i)
+				case 1:
+					ll.PushFront(i)
+				case 2:
+					ll.PopFront()
+				case 3:
+					ll.PopBack()
+				case 4:
+					if err := ll.TryInsert(ll.Begin(), i, i+1); err != nil {
+						assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+					}
+				case 5:
+					if it := ll.Begin(); it != ll.End() {
+						if err := ll.Erase(it); err != nil {
+							assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+						}
+					}
+				case 6:
+					traverse(t, ll)
+				case 7:
+					_, _, _, _ = ll.Front(), ll.Back(), ll.Size(), ll.Empty()
+					_ = ll.ToSlice()
+					for range ll.All() {
+					}
+				case 8:
+					drain(t, ll.Iter())
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	assertConsistent(t, ll)
+}
+
+func TestLinkedList_StressOperations(t *testing.T) {
+	const workers, operations = 8, 200
+	a := NewInt()
+	a.Insert(a.End(), 1, 2, 3)
+	b := NewInt()
+	b.Insert(b.End(), 4, 5, 6)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < operations; i++ {
+				src, dst := a, b
+				if worker%2 == 0 {
+					src, dst = b, a
+				}
+				switch (worker + i) % 7 {
+				case 0:
+					if err := dst.Splice(dst.Begin(), src); err != nil {
+						assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+					}
+				case 1:
+					dst.Swap(src)
+				case 2:
+					dst.Sort()
+				case 3:
+					dst.Reverse()
+				case 4:
+					dst.PushBack(i)
+					dst.Unique()
+				case 5:
+					dst.RemoveIf(func(value interface{}) bool { return value.(int)%5 == 0 })
+				case 6:
+					dst.Sort()
+					src.Sort()
+					dst.Merge(src)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	assertConsistent(t, a)
+	assertConsistent(t, b)
+}
+
+func TestIterator_FailFast(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3)
+	it := ll.Begin()
+	ll.PushBack(4)
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { it.Next() })
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { it.Value() })
+	assert.ErrorIs(t, ll.Erase(it), containers.ErrConcurrentModification)
+	assert.ErrorIs(t, ll.TryInsert(it, 5), containers.ErrConcurrentModification)
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { ll.Insert(it, 5) })
+	assert.Equal(t, []interface{}{1, 2, 3, 4}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	// Read-only operations don't invalidate the iterators
+	it = ll.RBegin()
+	_, _, _ = ll.Front(), ll.ToSlice(), ll.Display()
+	assert.Equal(t, 4, it.Value())
+
+	gIt := ll.Iter()
+	ll.Sort()
+	assert.True(t, gIt.HasNext())
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { gIt.Next() })
+}
+
+func TestIterator_ValidAfterModificationThroughIt(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3)
+	it, _ := ll.Begin().Advance(1)
+	ll.Insert(it, 10, 20)
+	assert.Equal(t, 2, it.Value())
+	assert.Equal(t, int64(3), it.Index())
+	assert.Equal(t, 3, it.Next().Value())
+
+	// The last iterator of an erased range remains valid
+	first, _ := ll.Begin().Advance(1)
+	assert.NoError(t, ll.Erase(first, it))
+	assert.Equal(t, 2, it.Value())
+	assert.Equal(t, int64(1), it.Index())
+	assert.Equal(t, []interface{}{1, 2, 3}, ll.ToSlice())
+	assertLinks(t, ll)
+
+	// Erasing a range in reverse
+	last := ll.RBegin()
+	ll.PushFront(0)
+	rFirst := ll.RBegin()
+	rLast, _ := ll.RBegin().Advance(2)
+	assert.NoError(t, ll.Erase(rFirst, rLast))
+	assert.Equal(t, []interface{}{0, 1}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.Equal(t, 1, rLast.Value())
+	assert.ErrorIs(t, ll.Erase(last), containers.ErrConcurrentModification)
+
+	// Erasing till the reverse end
+	assert.NoError(t, ll.Erase(ll.RBegin(), ll.REnd()))
+	assert.Empty(t, ll.ToSlice())
+	assertLinks(t, ll)
+}
+
+func TestLinkedList_Erase_Invalid(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2, 3)
+	other := NewInt()
+	other.Insert(other.End(), 1)
+	assert.Error(t, ll.Erase(ll.End()))
+	assert.Error(t, ll.Erase(other.Begin()))
+	assert.Error(t, ll.Erase(ll.RBegin(), ll.End()))
+	last, _ := ll.Begin().Advance(1)
+	first, _ := ll.Begin().Advance(2)
+	assert.Error(t, ll.Erase(first, last))
+	assert.Equal(t, []interface{}{1, 2, 3}, ll.ToSlice())
+	assertLinks(t, ll)
+	assert.NoError(t, ll.Erase(ll.Begin()))
+}
+
+func TestIterator_TryNext(t *testing.T) {
+	ll := NewInt()
+	ll.Insert(ll.End(), 1, 2)
+	it := ll.Begin()
+	next, err := it.TryNext()
+	assert.NoError(t, err)
+	value, err := next.TryValue()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, value)
+
+	end, err := next.TryNext()
+	assert.NoError(t, err)
+	assert.Equal(t, ll.End(), end)
+	_, err = end.TryNext()
+	assert.Error(t, err)
+	_, err = end.TryValue()
+	assert.Error(t, err)
+
+	ll.PopBack()
+	_, err = it.TryNext()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+	_, err = it.TryValue()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+}
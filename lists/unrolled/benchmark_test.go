@@ -0,0 +1,98 @@
+package unrolled
+
+import (
+	"github.com/soheltarir/gollections/lists"
+	"testing"
+)
+
+const benchmarkSize = 10000
+
+func BenchmarkList_PushBack(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := NewInt()
+		for j := 0; j < benchmarkSize; j++ {
+			l.PushBack(j)
+		}
+	}
+}
+
+func BenchmarkLinkedList_PushBack(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := lists.NewInt()
+		for j := 0; j < benchmarkSize; j++ {
+			l.PushBack(j)
+		}
+	}
+}
+
+func BenchmarkList_All(b *testing.B) {
+	l := NewInt()
+	for j := 0; j < benchmarkSize; j++ {
+		l.PushBack(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range l.All() {
+		}
+	}
+}
+
+func BenchmarkLinkedList_All(b *testing.B) {
+	l := lists.NewInt()
+	for j := 0; j < benchmarkSize; j++ {
+		l.PushBack(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range l.All() {
+		}
+	}
+}
+
+func BenchmarkList_Iterate(b *testing.B) {
+	l := NewInt()
+	for j := 0; j < benchmarkSize; j++ {
+		l.PushBack(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for it := l.Begin(); it != l.End(); it = it.Next() {
+			_ = it.Value()
+		}
+	}
+}
+
+func BenchmarkLinkedList_Iterate(b *testing.B) {
+	l := lists.NewInt()
+	for j := 0; j < benchmarkSize; j++ {
+		l.PushBack(j)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for it := l.Begin(); it != l.End(); it = it.Next() {
+			_ = it.Value()
+		}
+	}
+}
+
+func BenchmarkList_Insert(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := NewInt()
+		for j := 0; j < benchmarkSize/10; j++ {
+			l.Insert(l.At(l.Size()/2), j)
+		}
+	}
+}
+
+func BenchmarkLinkedList_Insert(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := lists.NewInt()
+		for j := 0; j < benchmarkSize/10; j++ {
+			l.Insert(l.At(l.Size()/2), j)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package unrolled
+
+import (
+	"errors"
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+)
+
+// errOutOfBounds is reported when an iterator is moved or dereferenced past the bounds of the list
+var errOutOfBounds = errors.New("iterator crossed list's bounds")
+
+type direction uint
+
+const (
+	forwardDirection direction = iota
+	backwardDirection
+)
+
+// Iterator is a stateful iterator for traversing an unrolled list.
+// Iterators are fail-fast; once the list is structurally modified (other than through Insert or Erase with the
+// iterator as the position), Next & Value panic with containers.ErrConcurrentModification instead of traversing
+// stale data.
+type Iterator struct {
+	list      *List
+	chunk     *chunk
+	offset    int
+	direction direction
+	index     int64
+	// modCount is the modification count of the list the iterator is valid for
+	modCount uint64
+}
+
+// Next returns the iterator to the next/previous element in the list based on the traversal direction. Panics if
+// the iterator reaches out of bounds, or with containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) Next() *Iterator {
+	next, err := it.TryNext()
+	if err != nil {
+		panic(err)
+	}
+	return next
+}
+
+// TryNext is similar to Next, but returns an error instead of panicking if the iterator reaches out of bounds, or
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) TryNext() (*Iterator, error) {
+	if it.chunk == nil {
+		return nil, errOutOfBounds
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if !it.valid() {
+		return nil, containers.ErrConcurrentModification
+	}
+	next := *it
+	if it.direction == forwardDirection {
+		next.index++
+		next.offset++
+		if next.offset == len(it.chunk.values) {
+			next.chunk, next.offset = it.chunk.next, 0
+		}
+	} else {
+		next.index--
+		next.offset--
+		if next.offset < 0 {
+			next.chunk = it.chunk.previous
+			if next.chunk != nil {
+				next.offset = len(next.chunk.values) - 1
+			}
+		}
+	}
+	if next.chunk == nil {
+		return it.list.endOf(it.direction), nil
+	}
+	return &next, nil
+}
+
+// Prev returns the iterator to the element preceding the current element based on the traversal direction, i.e.,
+// it moves the iterator a step back. The past-the-end iterators move to the last element of their traversal.
+// Panics if the iterator reaches out of bounds, or with containers.ErrConcurrentModification if the list has been
+// modified.
+func (it *Iterator) Prev() *Iterator {
+	prev, err := it.TryPrev()
+	if err != nil {
+		panic(err)
+	}
+	return prev
+}
+
+// TryPrev is similar to Prev, but returns an error instead of panicking if the iterator reaches out of bounds, or
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) TryPrev() (*Iterator, error) {
+	return it.move(-1)
+}
+
+// Advance moves the iterator by the no. of the steps provided; forward for positive steps & backward for negative
+// steps. Unlike lists.Iterator, whole chunks are skipped, hence advancing takes time proportional to the number of
+// chunks skipped. The iterator is left untouched if an error is returned. The past-the-end iterators are shared by
+// the list, hence they're never moved in place; the moved iterator is only returned for them.
+func (it *Iterator) Advance(steps int) (*Iterator, error) {
+	moved, err := it.move(int64(steps))
+	if err != nil {
+		return it, err
+	}
+	if it.chunk == nil {
+		return moved, nil
+	}
+	*it = *moved
+	return it, nil
+}
+
+// Value returns the current element's value. Panics if the iterator is out of bounds, or with
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) Value() interface{} {
+	value, err := it.TryValue()
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// TryValue is similar to Value, but returns an error instead of panicking if the iterator is out of bounds, or
+// containers.ErrConcurrentModification if the list has been modified.
+func (it *Iterator) TryValue() (interface{}, error) {
+	if it.chunk == nil {
+		return nil, errOutOfBounds
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if !it.valid() {
+		return nil, containers.ErrConcurrentModification
+	}
+	return containers.CleanBasicType(it.chunk.values[it.offset]), nil
+}
+
+// Index returns the current element's index in the list. The index of End is the size of the list, and the index of
+// REnd is -1.
+func (it *Iterator) Index() int64 {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	return it.position()
+}
+
+func (it *Iterator) IsEqual(it2 *Iterator) bool {
+	return it.chunk == it2.chunk && it.offset == it2.offset
+}
+
+// Distance returns the no. of steps required to move the first iterator to the last iterator, which is negative if
+// the last iterator precedes the first iterator in their traversal direction. The iterators should belong to the same
+// list, traverse it in the same direction, and have been created after the last modification of the list.
+func Distance(first, last *Iterator) (int64, error) {
+	if first.list != last.list {
+		return 0, fmt.Errorf("the iterators don't belong to the same list")
+	}
+	if first.direction != last.direction {
+		return 0, fmt.Errorf("the iterators should traverse the list in the same direction")
+	}
+	first.list.mu.RLock()
+	defer first.list.mu.RUnlock()
+
+	if !first.valid() || !last.valid() {
+		return 0, containers.ErrConcurrentModification
+	}
+	distance := last.position() - first.position()
+	if first.direction == backwardDirection {
+		distance = -distance
+	}
+	return distance, nil
+}
+
+// move returns a new iterator moved by the no. of steps provided in the direction of the iterator.
+func (it *Iterator) move(steps int64) (*Iterator, error) {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if !it.valid() {
+		return nil, containers.ErrConcurrentModification
+	}
+	if it.direction == backwardDirection {
+		steps = -steps
+	}
+	index := it.position() + steps
+	if index < -1 || index > it.list.size ||
+		(index == -1 && it.direction == forwardDirection) ||
+		(index == it.list.size && it.direction == backwardDirection) {
+		return nil, errOutOfBounds
+	}
+	if it.chunk == nil || index < 0 || index >= it.list.size {
+		// The past-the-end iterators are located from the nearer end of the list
+		return it.list.iteratorAt(index, it.direction), nil
+	}
+	// Skip whole chunks from the iterator's chunk until the offset falls within a chunk
+	c, offset := it.chunk, int64(it.offset)+index-it.index
+	for offset >= int64(len(c.values)) {
+		offset -= int64(len(c.values))
+		c = c.next
+	}
+	for offset < 0 {
+		c = c.previous
+		offset += int64(len(c.values))
+	}
+	return &Iterator{
+		list:      it.list,
+		chunk:     c,
+		offset:    int(offset),
+		direction: it.direction,
+		index:     index,
+		modCount:  it.list.modCount,
+	}, nil
+}
+
+// position returns the index of the iterator's element. The caller must hold the list's lock.
+func (it *Iterator) position() int64 {
+	switch {
+	case it.chunk != nil:
+		return it.index
+	case it.direction == forwardDirection:
+		return it.list.size
+	default:
+		return -1
+	}
+}
+
+// valid reports whether the list hasn't been modified since the iterator was created. The past-the-end iterators
+// are always valid. The caller must hold the list's lock.
+func (it *Iterator) valid() bool {
+	return it.chunk == nil || it.list.modCount == it.modCount
+}
+
+// checkPosition returns an error if the iterator can't be used as a position in the list, i.e., it belongs to
+// another list or the list has been modified since it was created. The caller must hold the list's lock.
+func (l *List) checkPosition(it *Iterator) error {
+	if it.list != l {
+		return fmt.Errorf("the iterator doesn't belong to the list")
+	}
+	if !it.valid() {
+		return containers.ErrConcurrentModification
+	}
+	return nil
+}
+
+// iteratorAt returns an iterator pointing to the element at the index, or the past-the-end iterator of the
+// direction if the index is out of range. The caller must hold the list's lock.
+func (l *List) iteratorAt(index int64, direction direction) *Iterator {
+	c, offset := l.locate(index)
+	if c == nil {
+		return l.endOf(direction)
+	}
+	return &Iterator{
+		list:      l,
+		chunk:     c,
+		offset:    offset,
+		direction: direction,
+		index:     index,
+		modCount:  l.modCount,
+	}
+}
+
+// endOf returns the past-the-end iterator of the direction.
+func (l *List) endOf(direction direction) *Iterator {
+	if direction == forwardDirection {
+		return l.end
+	}
+	return l.rend
+}
+
+// listIterator implements gollections.Iterator for an unrolled list, traversing it from the front to the back.
+type listIterator struct {
+	list     *List
+	current  containers.Container
+	next     *chunk
+	offset   int
+	modCount uint64
+}
+
+func (it *listIterator) HasNext() bool {
+	return it.next != nil
+}
+
+func (it *listIterator) Next() interface{} {
+	if it.next == nil {
+		panic("iterator has no more elements")
+	}
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if it.list.modCount != it.modCount {
+		panic(containers.ErrConcurrentModification)
+	}
+	it.current = it.next.values[it.offset]
+	it.offset++
+	if it.offset == len(it.next.values) {
+		it.next, it.offset = it.next.next, 0
+	}
+	return containers.CleanBasicType(it.current)
+}
+
+func (it *listIterator) Value() interface{} {
+	if it.current == nil {
+		return nil
+	}
+	return containers.CleanBasicType(it.current)
+}
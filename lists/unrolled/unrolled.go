@@ -0,0 +1,681 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package unrolled exposes an unrolled linked list data-structure, https://en.wikipedia.org/wiki/Unrolled_linked_list.
+//
+// Unrolled lists are doubly-linked lists of chunks, each chunk storing up to a fixed number of elements in an array.
+// Compared to the lists package, which allocates a node per element, the elements are stored contiguously, hence
+// iterating the list is cache-friendly and the link overhead is shared by all the elements of a chunk. Inserting or
+// erasing an element takes time proportional to the chunk size instead of constant time.
+package unrolled
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"iter"
+	"strings"
+	"sync"
+)
+
+// DefaultChunkSize is the default maximum number of elements stored by a chunk of the list
+const DefaultChunkSize = 64
+
+// chunk is a node of the list, storing up to the chunk size of the list elements
+type chunk struct {
+	values   []containers.Container
+	next     *chunk
+	previous *chunk
+}
+
+// List is a sequence container storing its elements in a doubly-linked list of fixed size arrays. It exposes the same
+// API as lists.LinkedList.
+//
+// All the operations on the list are thread-safe. The iterators of the list are fail-fast, i.e., once the list is
+// structurally modified, the iterators created before the modification report containers.ErrConcurrentModification.
+// Insert & Erase keep the position iterator provided to them valid.
+type List struct {
+	head      *chunk
+	tail      *chunk
+	size      int64
+	chunkSize int
+	valueType containers.Container
+	// modCount is incremented on every structural modification of the list, to invalidate its iterators
+	modCount uint64
+	// end & rend are the past-the-end iterators of the list in either direction
+	end  *Iterator
+	rend *Iterator
+	mu   sync.RWMutex
+}
+
+/** Element Access **/
+
+// Front returns the value of the first element of the list, or nil if the list is empty.
+func (l *List) Front() interface{} {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.head == nil {
+		return nil
+	}
+	return containers.CleanBasicType(l.head.values[0])
+}
+
+// Back returns the value of the last element of the list, or nil if the list is empty.
+func (l *List) Back() interface{} {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.tail == nil {
+		return nil
+	}
+	return containers.CleanBasicType(l.tail.values[len(l.tail.values)-1])
+}
+
+/** Iterators **/
+
+// Begin returns an iterator pointing to the first element of the list, or End() if the list is empty.
+func (l *List) Begin() *Iterator {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.iteratorAt(0, forwardDirection)
+}
+
+// End returns an iterator referring to the past-the-end element of the list. The iterator is shared, hence it can be
+// compared with the iterators returned by Next, and remains valid across modifications of the list.
+func (l *List) End() *Iterator {
+	return l.end
+}
+
+// RBegin returns a reverse iterator pointing to the last element of the list, or REnd() if the list is empty.
+// Reverse iterators iterate backwards: increasing them moves them towards the beginning of the list.
+func (l *List) RBegin() *Iterator {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.iteratorAt(l.size-1, backwardDirection)
+}
+
+// REnd returns a reverse iterator pointing to the theoretical element preceding the first element of the list.
+func (l *List) REnd() *Iterator {
+	return l.rend
+}
+
+// At returns an iterator pointing to the element at the specified index. At(Size()) returns End().
+// Panics if the index is out of range.
+func (l *List) At(index int64) *Iterator {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if index < 0 || index > l.size {
+		panic(fmt.Sprintf("index %d out of range for list of size %d", index, l.size))
+	}
+	return l.iteratorAt(index, forwardDirection)
+}
+
+// All returns an iterator over the index-value pairs of the list from the front to the back.
+// The list is read locked during the iteration, hence the loop body must not modify the list.
+//
+//	for i, v := range list.All() {
+//		...
+//	}
+func (l *List) All() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		l.mu.RLock()
+		defer l.mu.RUnlock()
+
+		var index int64
+		for c := l.head; c != nil; c = c.next {
+			for _, value := range c.values {
+				if !yield(index, containers.CleanBasicType(value)) {
+					return
+				}
+				index++
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index-value pairs of the list from the back to the front.
+// The locking semantics are the same as All.
+func (l *List) Backward() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		l.mu.RLock()
+		defer l.mu.RUnlock()
+
+		index := l.size - 1
+		for c := l.tail; c != nil; c = c.previous {
+			for i := len(c.values) - 1; i >= 0; i-- {
+				if !yield(index, containers.CleanBasicType(c.values[i])) {
+					return
+				}
+				index--
+			}
+		}
+	}
+}
+
+/** Modifiers **/
+
+// PushFront inserts a new element at the beginning of the list, right before its current first element.
+// Panics if an invalid type is provided.
+func (l *List) PushFront(val interface{}) {
+	l.pushFront(l.valueType.Validate(val))
+}
+
+// TryPushFront is similar to PushFront, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *List) TryPushFront(val interface{}) error {
+	element, err := containers.TryValidate(l.valueType, val)
+	if err != nil {
+		return err
+	}
+	l.pushFront(element)
+	return nil
+}
+
+func (l *List) pushFront(element containers.Container) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.insertAt(l.head, 0, element)
+	l.modCount++
+}
+
+// PushBack adds a new element at the end of the list, after its current last element.
+// Panics if an invalid type is provided.
+func (l *List) PushBack(val interface{}) {
+	l.pushBack(l.valueType.Validate(val))
+}
+
+// TryPushBack is similar to PushBack, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (l *List) TryPushBack(val interface{}) error {
+	element, err := containers.TryValidate(l.valueType, val)
+	if err != nil {
+		return err
+	}
+	l.pushBack(element)
+	return nil
+}
+
+func (l *List) pushBack(element containers.Container) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.insertAt(nil, 0, element)
+	l.modCount++
+}
+
+// PopFront removes the first element of the list, and returns its value. Returns nil if the list is empty.
+func (l *List) PopFront() interface{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.head == nil {
+		return nil
+	}
+	value := l.head.values[0]
+	l.removeRange(l.head, 0, 1)
+	return containers.CleanBasicType(value)
+}
+
+// PopBack removes the last element of the list, and returns its value. Returns nil if the list is empty.
+func (l *List) PopBack() interface{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.tail == nil {
+		return nil
+	}
+	last := len(l.tail.values) - 1
+	value := l.tail.values[last]
+	l.removeRange(l.tail, last, 1)
+	return containers.CleanBasicType(value)
+}
+
+// Insert inserts the elements before the element at the specified position. The position remains valid.
+// Panics if an invalid type or position is provided.
+func (l *List) Insert(it *Iterator, elements ...interface{}) {
+	if err := l.TryInsert(it, elements...); err != nil {
+		panic(err)
+	}
+}
+
+// TryInsert is similar to Insert, but returns an error instead of panicking if an invalid type or position is
+// provided.
+func (l *List) TryInsert(it *Iterator, elements ...interface{}) error {
+	values := make([]containers.Container, 0, len(elements))
+	for _, element := range elements {
+		value, err := containers.TryValidate(l.valueType, element)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.checkPosition(it); err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	c, offset := it.chunk, it.offset
+	if it.chunk == nil && it.direction == backwardDirection {
+		// Inserting before the theoretical element preceding the first element, i.e., at the front
+		c = l.head
+	}
+	for _, value := range values {
+		c, offset = l.insertAt(c, offset, value)
+	}
+	l.modCount++
+	if it.chunk != nil {
+		it.chunk, it.offset = l.normalize(c, offset)
+		it.index += int64(len(values))
+		it.modCount = l.modCount
+	}
+	return nil
+}
+
+// Erase removes from the list either a single element or a range of elements ([first,last)).
+// Note: The bounds are including the first iterator & excluding the last iterator. The iterators provided must have
+// been created after the last modification of the list, otherwise containers.ErrConcurrentModification is returned.
+// The last iterator of a range remains valid.
+func (l *List) Erase(iterators ...*Iterator) error {
+	if len(iterators) > 2 || len(iterators) == 0 {
+		return fmt.Errorf("please provide a single iterator or the iterator bounds (i.e., only two iterators)")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, it := range iterators {
+		if err := l.checkPosition(it); err != nil {
+			return err
+		}
+	}
+	first := iterators[0]
+	if first.chunk == nil {
+		return fmt.Errorf("cannot erase the end of the list")
+	}
+	if len(iterators) == 1 {
+		l.removeRange(first.chunk, first.offset, 1)
+		return nil
+	}
+
+	last := iterators[1]
+	if first.direction != last.direction {
+		return fmt.Errorf("the iterator bounds should traverse the list in the same direction")
+	}
+	start, stop := first.position(), last.position()
+	if first.direction == backwardDirection {
+		// The range is erased from the front to the back, i.e., from the element succeeding the last iterator
+		start, stop = stop+1, start+1
+	}
+	if start > stop {
+		return fmt.Errorf("the last iterator is not reachable from the first iterator")
+	}
+	if start == stop {
+		return nil
+	}
+	c, offset := l.locate(start)
+	l.removeRange(c, offset, stop-start)
+	if last.chunk != nil {
+		if first.direction == forwardDirection {
+			last.index -= stop - start
+		}
+		last.chunk, last.offset = l.locate(last.index)
+		last.modCount = l.modCount
+	}
+	return nil
+}
+
+// Clear removes all the elements from the list, leaving it with a size of 0.
+func (l *List) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.head, l.tail, l.size = nil, nil, 0
+	l.modCount++
+}
+
+/** Capacity Functions **/
+
+// Size returns the number of elements in the list
+func (l *List) Size() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.size
+}
+
+// Empty reports whether the list is empty
+func (l *List) Empty() bool {
+	return l.Size() == 0
+}
+
+/** Collection Functions **/
+
+// ToSlice returns the values of the list's elements from the front to the back.
+func (l *List) ToSlice() []interface{} {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	values := make([]interface{}, 0, l.size)
+	for c := l.head; c != nil; c = c.next {
+		for _, value := range c.values {
+			values = append(values, containers.CleanBasicType(value))
+		}
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing the list from the front to the back.
+func (l *List) Iter() gollections.Iterator {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return &listIterator{list: l, next: l.head, modCount: l.modCount}
+}
+
+/** Display Functions **/
+
+// Display returns a string representation of the list.
+func (l *List) Display() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var b strings.Builder
+	for c := l.head; c != nil; c = c.next {
+		for i, value := range c.values {
+			if c != l.head || i > 0 {
+				b.WriteString(" <-> ")
+			}
+			_, _ = fmt.Fprintf(&b, "%v", value.Key())
+		}
+	}
+	return b.String()
+}
+
+/** Helpers **/
+
+// insertAt inserts the element before the offset of the chunk, where a nil chunk signifies the end of the list.
+// Full chunks are split in halves, except when inserting at their edges, where a new chunk is linked instead.
+// Returns the position following the inserted element. The caller must hold the list's write lock.
+func (l *List) insertAt(c *chunk, offset int, element containers.Container) (*chunk, int) {
+	if c == nil {
+		c = l.tail
+		if c == nil {
+			c = l.linkChunk(nil)
+		}
+		offset = len(c.values)
+	}
+	if len(c.values) == l.chunkSize {
+		switch {
+		case offset == l.chunkSize && c.next != nil && len(c.next.values) < l.chunkSize:
+			c, offset = c.next, 0
+		case offset == l.chunkSize:
+			c, offset = l.linkChunk(c), 0
+		case offset == 0 && c.previous != nil && len(c.previous.values) < l.chunkSize:
+			c, offset = c.previous, len(c.previous.values)
+		case offset == 0:
+			c = l.linkChunk(c.previous)
+		default:
+			half := l.chunkSize / 2
+			n := l.linkChunk(c)
+			n.values = append(n.values, c.values[half:]...)
+			clear(c.values[half:])
+			c.values = c.values[:half]
+			if offset > half {
+				c, offset = n, offset-half
+			}
+		}
+	}
+	c.values = append(c.values, nil)
+	copy(c.values[offset+1:], c.values[offset:])
+	c.values[offset] = element
+	l.size++
+	return c, offset + 1
+}
+
+// removeRange removes count elements starting from the offset of the chunk, merging the under-filled chunks
+// around the removed range. The caller must hold the list's write lock.
+func (l *List) removeRange(c *chunk, offset int, count int64) {
+	before := c
+	if offset == 0 {
+		before = c.previous
+	}
+	for count > 0 {
+		n := min(count, int64(len(c.values)-offset))
+		end := offset + int(n)
+		copy(c.values[offset:], c.values[end:])
+		clear(c.values[len(c.values)-int(n):])
+		c.values = c.values[:len(c.values)-int(n)]
+		l.size -= n
+		count -= n
+
+		next := c.next
+		if len(c.values) == 0 {
+			l.unlinkChunk(c)
+		}
+		if count > 0 || len(c.values) == 0 {
+			c, offset = next, 0
+		}
+	}
+	// c is the chunk following the removed range, if it wasn't the last chunk
+	if c != nil && c != before {
+		l.merge(c)
+	}
+	if before != nil {
+		l.merge(before)
+	}
+	l.modCount++
+}
+
+// merge merges the following chunk into the chunk, provided the chunk is less than half full and both of their
+// elements fit in a chunk. The caller must hold the list's write lock.
+func (l *List) merge(c *chunk) {
+	next := c.next
+	if next == nil || len(c.values) >= l.chunkSize/2 || len(c.values)+len(next.values) > l.chunkSize {
+		return
+	}
+	c.values = append(c.values, next.values...)
+	l.unlinkChunk(next)
+}
+
+// linkChunk links a new empty chunk after the chunk provided, or at the front of the list if the chunk is nil.
+// The caller must hold the list's write lock.
+func (l *List) linkChunk(previous *chunk) *chunk {
+	c := &chunk{values: make([]containers.Container, 0, l.chunkSize), previous: previous}
+	if previous == nil {
+		c.next, l.head = l.head, c
+	} else {
+		c.next, previous.next = previous.next, c
+	}
+	if c.next == nil {
+		l.tail = c
+	} else {
+		c.next.previous = c
+	}
+	return c
+}
+
+// unlinkChunk unlinks the chunk from the list. The caller must hold the list's write lock.
+func (l *List) unlinkChunk(c *chunk) {
+	if c.previous == nil {
+		l.head = c.next
+	} else {
+		c.previous.next = c.next
+	}
+	if c.next == nil {
+		l.tail = c.previous
+	} else {
+		c.next.previous = c.previous
+	}
+}
+
+// locate returns the chunk & the offset of the element at the index, walking from whichever end of the list is
+// closer. Returns a nil chunk for indices out of range. The caller must hold the list's lock.
+func (l *List) locate(index int64) (*chunk, int) {
+	if index < 0 || index >= l.size {
+		return nil, 0
+	}
+	if index < l.size/2 {
+		c := l.head
+		for index >= int64(len(c.values)) {
+			index -= int64(len(c.values))
+			c = c.next
+		}
+		return c, int(index)
+	}
+	c := l.tail
+	remaining := l.size - 1 - index
+	for remaining >= int64(len(c.values)) {
+		remaining -= int64(len(c.values))
+		c = c.previous
+	}
+	return c, len(c.values) - 1 - int(remaining)
+}
+
+// normalize returns the position of the element following the offset of the chunk, moving to the next chunk if the
+// offset is past the end of the chunk. The caller must hold the list's lock.
+func (l *List) normalize(c *chunk, offset int) (*chunk, int) {
+	if offset < len(c.values) {
+		return c, offset
+	}
+	return c.next, 0
+}
+
+/** Constructors **/
+
+// New constructs an empty unrolled list of the Container type provided, with chunks of DefaultChunkSize elements.
+func New(valueType containers.Container) *List {
+	return NewWithChunkSize(valueType, DefaultChunkSize)
+}
+
+// NewWithChunkSize constructs an empty unrolled list of the Container type provided, with chunks of up to chunkSize
+// elements. Larger chunks improve the iteration speed & the memory usage, at the cost of slower insertions and
+// deletions. Panics if the chunk size is less than 2.
+func NewWithChunkSize(valueType containers.Container, chunkSize int) *List {
+	if chunkSize < 2 {
+		panic(fmt.Sprintf("chunk size should be at least 2, received: %d", chunkSize))
+	}
+	l := &List{valueType: valueType, chunkSize: chunkSize}
+	l.end = &Iterator{list: l, direction: forwardDirection}
+	l.rend = &Iterator{list: l, direction: backwardDirection}
+	return l
+}
+
+// NewInt constructs an empty integer unrolled list, with no elements.
+func NewInt() *List {
+	return New(containers.IntContainer(0))
+}
+
+// NewString constructs an empty string unrolled list, with no elements.
+func NewString() *List {
+	return New(containers.StringContainer(""))
+}
+
+// NewFloat64 constructs an empty float64 unrolled list, with no elements.
+func NewFloat64() *List {
+	return New(containers.Float64Container(0))
+}
+
+// NewInt64 constructs an empty int64 unrolled list, with no elements.
+func NewInt64() *List {
+	return New(containers.Int64Container(0))
+}
+
+// NewUint64 constructs an empty uint64 unrolled list, with no elements.
+func NewUint64() *List {
+	return New(containers.Uint64Container(0))
+}
+
+// NewInt8 constructs an empty int8 unrolled list, with no elements.
+func NewInt8() *List {
+	return New(containers.Int8Container(0))
+}
+
+// NewInt16 constructs an empty int16 unrolled list, with no elements.
+func NewInt16() *List {
+	return New(containers.Int16Container(0))
+}
+
+// NewInt32 constructs an empty int32 unrolled list, with no elements.
+func NewInt32() *List {
+	return New(containers.Int32Container(0))
+}
+
+// NewUint constructs an empty uint unrolled list, with no elements.
+func NewUint() *List {
+	return New(containers.UintContainer(0))
+}
+
+// NewUint8 constructs an empty uint8 unrolled list, with no elements.
+func NewUint8() *List {
+	return New(containers.Uint8Container(0))
+}
+
+// NewUint16 constructs an empty uint16 unrolled list, with no elements.
+func NewUint16() *List {
+	return New(containers.Uint16Container(0))
+}
+
+// NewUint32 constructs an empty uint32 unrolled list, with no elements.
+func NewUint32() *List {
+	return New(containers.Uint32Container(0))
+}
+
+// NewFloat32 constructs an empty float32 unrolled list, with no elements.
+func NewFloat32() *List {
+	return New(containers.Float32Container(0))
+}
+
+// NewRune constructs an empty rune unrolled list, with no elements.
+func NewRune() *List {
+	return New(containers.RuneContainer(0))
+}
+
+// NewBool constructs an empty bool unrolled list, with no elements.
+func NewBool() *List {
+	return New(containers.BoolContainer(false))
+}
+
+// NewTime constructs an empty time.Time unrolled list, with no elements.
+func NewTime() *List {
+	return New(containers.TimeContainer{})
+}
+
+// NewDuration constructs an empty time.Duration unrolled list, with no elements.
+func NewDuration() *List {
+	return New(containers.DurationContainer(0))
+}
+
+// NewBytes constructs an empty byte slice unrolled list, with no elements.
+func NewBytes() *List {
+	return New(containers.BytesContainer{})
+}
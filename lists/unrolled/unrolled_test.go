@@ -0,0 +1,359 @@
+package unrolled
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// assertChunks asserts the consistency of the chunks of the list, i.e., their links, sizes & the size of the list
+func assertChunks(t *testing.T, l *List) {
+	t.Helper()
+	var size int64
+	var previous *chunk
+	for c := l.head; c != nil; c = c.next {
+		assert.NotEmpty(t, c.values, "empty chunk")
+		assert.LessOrEqual(t, len(c.values), l.chunkSize)
+		assert.Equal(t, previous, c.previous)
+		size += int64(len(c.values))
+		previous = c
+	}
+	assert.Equal(t, previous, l.tail)
+	assert.Equal(t, l.size, size)
+}
+
+func TestNew(t *testing.T) {
+	l := NewInt()
+	assert.True(t, l.Empty())
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+	assert.Nil(t, l.PopFront())
+	assert.Nil(t, l.PopBack())
+	assert.Equal(t, l.End(), l.Begin())
+	assert.Equal(t, l.REnd(), l.RBegin())
+	assert.Equal(t, DefaultChunkSize, l.chunkSize)
+	assert.Panics(t, func() { NewWithChunkSize(containers.IntContainer(0), 1) })
+
+	assert.Equal(t, containers.StringContainer(""), NewString().valueType)
+	assert.Equal(t, containers.Float64Container(0), NewFloat64().valueType)
+	assert.Equal(t, containers.Int64Container(0), NewInt64().valueType)
+	assert.Equal(t, containers.Uint64Container(0), NewUint64().valueType)
+	assert.Equal(t, containers.Int8Container(0), NewInt8().valueType)
+	assert.Equal(t, containers.Int16Container(0), NewInt16().valueType)
+	assert.Equal(t, containers.Int32Container(0), NewInt32().valueType)
+	assert.Equal(t, containers.UintContainer(0), NewUint().valueType)
+	assert.Equal(t, containers.Uint8Container(0), NewUint8().valueType)
+	assert.Equal(t, containers.Uint16Container(0), NewUint16().valueType)
+	assert.Equal(t, containers.Uint32Container(0), NewUint32().valueType)
+	assert.Equal(t, containers.Float32Container(0), NewFloat32().valueType)
+	assert.Equal(t, containers.RuneContainer(0), NewRune().valueType)
+	assert.Equal(t, containers.BoolContainer(false), NewBool().valueType)
+	assert.Equal(t, containers.TimeContainer{}, NewTime().valueType)
+	assert.Equal(t, containers.DurationContainer(0), NewDuration().valueType)
+	assert.Equal(t, containers.BytesContainer{}, NewBytes().valueType)
+}
+
+func TestList_Push(t *testing.T) {
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	for i := 5; i < 10; i++ {
+		l.PushBack(i)
+	}
+	for i := 4; i >= 0; i-- {
+		l.PushFront(i)
+	}
+	assert.Equal(t, []interface{}{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, l.ToSlice())
+	assertChunks(t, l)
+	assert.Equal(t, 0, l.Front())
+	assert.Equal(t, 9, l.Back())
+	assert.Equal(t, "0 <-> 1 <-> 2 <-> 3 <-> 4 <-> 5 <-> 6 <-> 7 <-> 8 <-> 9", l.Display())
+
+	assert.Panics(t, func() { l.PushBack("10") })
+	assert.Panics(t, func() { l.PushFront("10") })
+	var typeErr *containers.TypeError
+	assert.ErrorAs(t, l.TryPushBack("10"), &typeErr)
+	assert.ErrorAs(t, l.TryPushFront("10"), &typeErr)
+	assert.NoError(t, l.TryPushBack(10))
+	assert.NoError(t, l.TryPushFront(-1))
+	assert.Equal(t, int64(12), l.Size())
+}
+
+func TestList_Pop(t *testing.T) {
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	l.Insert(l.End(), 1, 2, 3, 4, 5, 6)
+	assert.Equal(t, 1, l.PopFront())
+	assert.Equal(t, 6, l.PopBack())
+	assert.Equal(t, []interface{}{2, 3, 4, 5}, l.ToSlice())
+	assertChunks(t, l)
+	for !l.Empty() {
+		l.PopBack()
+	}
+	assert.Equal(t, []interface{}{}, l.ToSlice())
+	assertChunks(t, l)
+	l.PushFront(1)
+	assert.Equal(t, []interface{}{1}, l.ToSlice())
+	assertChunks(t, l)
+}
+
+func TestList_Insert(t *testing.T) {
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	l.Insert(l.End(), 1, 2, 3, 4)
+	it := l.At(2)
+	l.Insert(it, 10, 11, 12)
+	assert.Equal(t, []interface{}{1, 2, 10, 11, 12, 3, 4}, l.ToSlice())
+	assertChunks(t, l)
+	// The position remains valid
+	assert.Equal(t, 3, it.Value())
+	assert.Equal(t, int64(5), it.Index())
+	l.Insert(it, 13)
+	assert.Equal(t, []interface{}{1, 2, 10, 11, 12, 13, 3, 4}, l.ToSlice())
+	assertChunks(t, l)
+
+	l.Insert(l.Begin(), 0)
+	l.Insert(l.End(), 5)
+	l.Insert(l.REnd(), -1)
+	assert.Equal(t, []interface{}{-1, 0, 1, 2, 10, 11, 12, 13, 3, 4, 5}, l.ToSlice())
+	assertChunks(t, l)
+
+	rIt := l.RBegin()
+	l.Insert(rIt, 6)
+	assert.Equal(t, []interface{}{-1, 0, 1, 2, 10, 11, 12, 13, 3, 4, 6, 5}, l.ToSlice())
+	assertChunks(t, l)
+	assert.Equal(t, 5, rIt.Value())
+
+	assert.Error(t, l.TryInsert(l.Begin(), "1"))
+	assert.Error(t, l.TryInsert(NewInt().End(), 1))
+	stale := l.Begin()
+	l.PushBack(7)
+	assert.ErrorIs(t, l.TryInsert(stale, 1), containers.ErrConcurrentModification)
+	assert.Panics(t, func() { l.Insert(stale, 1) })
+	assert.NoError(t, l.TryInsert(l.Begin()))
+}
+
+func TestList_Erase(t *testing.T) {
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	l.Insert(l.End(), 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	assert.NoError(t, l.Erase(l.At(3)))
+	assert.Equal(t, []interface{}{0, 1, 2, 4, 5, 6, 7, 8, 9}, l.ToSlice())
+	assertChunks(t, l)
+
+	last := l.At(7)
+	assert.NoError(t, l.Erase(l.At(1), last))
+	assert.Equal(t, []interface{}{0, 8, 9}, l.ToSlice())
+	assertChunks(t, l)
+	// The last iterator remains valid
+	assert.Equal(t, 8, last.Value())
+	assert.Equal(t, int64(1), last.Index())
+
+	assert.NoError(t, l.Erase(last, l.End()))
+	assert.Equal(t, []interface{}{0}, l.ToSlice())
+	assertChunks(t, l)
+
+	l.Insert(l.End(), 1, 2, 3, 4, 5, 6)
+	// Reverse range, i.e., the elements (1, 5]
+	rFirst, _ := l.RBegin().Advance(1)
+	rLast, _ := l.RBegin().Advance(5)
+	assert.NoError(t, l.Erase(rFirst, rLast))
+	assert.Equal(t, []interface{}{0, 1, 6}, l.ToSlice())
+	assertChunks(t, l)
+	assert.Equal(t, 1, rLast.Value())
+
+	assert.Error(t, l.Erase())
+	assert.Error(t, l.Erase(l.End()))
+	assert.Error(t, l.Erase(l.End(), l.Begin()))
+	assert.Error(t, l.Erase(l.Begin(), l.REnd()))
+	assert.Error(t, l.Erase(l.At(2), l.At(1)))
+	assert.NoError(t, l.Erase(l.At(1), l.At(1)))
+	stale := l.Begin()
+	l.PushBack(7)
+	assert.ErrorIs(t, l.Erase(stale), containers.ErrConcurrentModification)
+
+	assert.NoError(t, l.Erase(l.Begin(), l.End()))
+	assert.Equal(t, []interface{}{}, l.ToSlice())
+	assertChunks(t, l)
+}
+
+func TestList_Clear(t *testing.T) {
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	l.Insert(l.End(), 1, 2, 3, 4, 5)
+	it := l.Begin()
+	l.Clear()
+	assert.Equal(t, []interface{}{}, l.ToSlice())
+	assertChunks(t, l)
+	assert.Panics(t, func() { it.Next() })
+	l.PushBack(1)
+	assert.Equal(t, []interface{}{1}, l.ToSlice())
+	assertChunks(t, l)
+}
+
+func TestIterator(t *testing.T) {
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	l.Insert(l.End(), 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	var actual []interface{}
+	for it := l.Begin(); it != l.End(); it = it.Next() {
+		assert.Equal(t, int64(len(actual)), it.Index())
+		actual = append(actual, it.Value())
+	}
+	assert.Equal(t, l.ToSlice(), actual)
+
+	actual = nil
+	for it := l.RBegin(); it != l.REnd(); it = it.Next() {
+		assert.Equal(t, int64(9-len(actual)), it.Index())
+		actual = append(actual, it.Value())
+	}
+	assert.Equal(t, []interface{}{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, actual)
+
+	assert.Equal(t, 9, l.End().Prev().Value())
+	assert.Equal(t, 0, l.REnd().Prev().Value())
+	assert.Equal(t, int64(10), l.End().Index())
+	assert.Equal(t, int64(-1), l.REnd().Index())
+	assert.Panics(t, func() { l.Begin().Prev() })
+	assert.Panics(t, func() { l.End().Next() })
+	_, err := l.End().TryValue()
+	assert.Error(t, err)
+
+	it := l.Begin()
+	_, err = it.Advance(7)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, it.Value())
+	_, err = it.Advance(-5)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, it.Value())
+	_, err = it.Advance(-3)
+	assert.Error(t, err)
+	assert.Equal(t, 2, it.Value())
+	end, err := l.End().Advance(-10)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, end.Value())
+	assert.Equal(t, int64(10), l.End().Index())
+	assert.True(t, l.At(10).IsEqual(l.End()))
+
+	distance, err := Distance(l.At(2), l.End())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), distance)
+	distance, err = Distance(l.RBegin(), l.REnd())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), distance)
+	_, err = Distance(l.Begin(), l.REnd())
+	assert.Error(t, err)
+	_, err = Distance(l.Begin(), NewInt().End())
+	assert.Error(t, err)
+
+	l.PushBack(10)
+	_, err = it.TryNext()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+	_, err = it.TryValue()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+	_, err = Distance(it, l.End())
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+	assert.Panics(t, func() { l.At(12) })
+}
+
+func TestIterator_Advance(t *testing.T) {
+	// Erasing some elements leaves chunks of different sizes
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	for i := 0; i < 30; i++ {
+		l.PushBack(i)
+	}
+	for _, index := range []int64{25, 17, 16, 9, 3, 2} {
+		assert.NoError(t, l.Erase(l.At(index)))
+	}
+	size := l.Size()
+	for from := int64(0); from < size; from++ {
+		for to := int64(0); to <= size; to++ {
+			it, err := l.At(from).Advance(int(to - from))
+			assert.NoError(t, err)
+			assert.True(t, it.IsEqual(l.At(to)), "advancing from %d to %d", from, to)
+			assert.Equal(t, to, it.Index())
+		}
+	}
+}
+
+func TestList_All(t *testing.T) {
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	l.Insert(l.End(), 0, 1, 2, 3, 4, 5)
+	for i, v := range l.All() {
+		assert.Equal(t, int(i), v)
+	}
+	var backward []interface{}
+	for i, v := range l.Backward() {
+		assert.Equal(t, int(i), v)
+		backward = append(backward, v)
+		if i == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []interface{}{5, 4, 3}, backward)
+
+	it := l.Iter()
+	var actual []interface{}
+	for it.HasNext() {
+		actual = append(actual, it.Next())
+		assert.Equal(t, actual[len(actual)-1], it.Value())
+	}
+	assert.Equal(t, l.ToSlice(), actual)
+	it = l.Iter()
+	it.Next()
+	l.PopFront()
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { it.Next() })
+}
+
+// TestList_Random compares the list against a slice, for a random sequence of operations
+func TestList_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	expected := []interface{}{}
+	for i := 0; i < 5000; i++ {
+		size := len(expected)
+		switch op := random.Intn(6); {
+		case op == 0:
+			l.PushBack(i)
+			expected = append(expected, i)
+		case op == 1:
+			l.PushFront(i)
+			expected = append([]interface{}{i}, expected...)
+		case op == 2:
+			index := random.Intn(size + 1)
+			l.Insert(l.At(int64(index)), i, i+1)
+			expected = append(expected[:index], append([]interface{}{i, i + 1}, expected[index:]...)...)
+		case op == 3 && size > 0:
+			index := random.Intn(size)
+			assert.NoError(t, l.Erase(l.At(int64(index))))
+			expected = append(expected[:index], expected[index+1:]...)
+		case op == 4 && size > 0:
+			first := random.Intn(size)
+			last := first + random.Intn(min(size-first, 10)+1)
+			assert.NoError(t, l.Erase(l.At(int64(first)), l.At(int64(last))))
+			expected = append(expected[:first], expected[last:]...)
+		case op == 5 && size > 0:
+			assert.Equal(t, expected[0], l.PopFront())
+			expected = expected[1:]
+		}
+		if i%100 == 0 {
+			assert.Equal(t, expected, l.ToSlice())
+			assertChunks(t, l)
+		}
+	}
+	assert.Equal(t, expected, l.ToSlice())
+	assertChunks(t, l)
+}
+
+func TestList_Concurrency(t *testing.T) {
+	l := NewWithChunkSize(containers.IntContainer(0), 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				l.PushBack(i)
+				l.PushFront(j)
+				l.PopBack()
+				_ = l.ToSlice()
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int64(800), l.Size())
+}
@@ -26,8 +26,10 @@ SOFTWARE.
 package counter
 
 import (
+	"github.com/soheltarir/gollections"
 	"github.com/soheltarir/gollections/containers"
-	"github.com/soheltarir/gollections/trees/heaps"
+	"github.com/soheltarir/gollections/queue/priority"
+	"iter"
 	"sync"
 )
 
@@ -44,10 +46,60 @@ type Counter struct {
 	datatype  containers.Container
 }
 
+// Size returns the number of distinct elements in the counter
 func (c *Counter) Size() int {
 	return c.size
 }
 
+// Empty reports whether the counter has no elements
+func (c *Counter) Empty() bool {
+	return c.size == 0
+}
+
+// Clear removes all the elements from the counter
+func (c *Counter) Clear() {
+	c.countMap.Range(func(key, _ interface{}) bool {
+		c.countMap.Delete(key)
+		c.objectMap.Delete(key)
+		return true
+	})
+	c.size = 0
+}
+
+// ToSlice returns the distinct elements of the counter, in no particular order.
+func (c *Counter) ToSlice() []interface{} {
+	var values []interface{}
+	c.countMap.Range(func(key, _ interface{}) bool {
+		if obj, found := c._getFromObjectMap(key); found {
+			values = append(values, containers.CleanBasicType(obj))
+		}
+		return true
+	})
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the distinct elements of the counter, in no
+// particular order.
+func (c *Counter) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(c.ToSlice())
+}
+
+// Collection adapts a Counter to the gollections.Collection interface, whose Size returns an int64, while Counter's
+// Size returns an int.
+type Collection struct {
+	*Counter
+}
+
+// Size returns the number of distinct elements in the counter
+func (c Collection) Size() int64 {
+	return int64(c.Counter.Size())
+}
+
+// AsCollection returns the counter adapted to the gollections.Collection & gollections.Iterable interfaces.
+func (c *Counter) AsCollection() Collection {
+	return Collection{Counter: c}
+}
+
 func (c *Counter) _getFromCountMap(key interface{}) (int, bool) {
 	value, found := c.countMap.Load(key)
 	if !found {
@@ -66,12 +118,41 @@ func (c *Counter) _storeInObjectMap(key interface{}, value containers.Container)
 
 func (c *Counter) _getFromObjectMap(key interface{}) (containers.Container, bool) {
 	value, found := c.objectMap.Load(key)
+	if !found {
+		return nil, found
+	}
 	return value.(containers.Container), found
 }
 
+// replace replaces the contents of the counter with the elements & their counts provided.
+func (c *Counter) replace(elements []containers.Container, counts []int) {
+	c.Clear()
+	for i, element := range elements {
+		if _, found := c._getFromCountMap(element.Key()); !found {
+			c.size++
+		}
+		c._storeInCountMap(element.Key(), counts[i])
+		c._storeInObjectMap(element.Key(), element)
+	}
+}
+
 // Add increments the counter for the element provided
 func (c *Counter) Add(element interface{}) {
-	x := c.datatype.Validate(element)
+	c.add(c.datatype.Validate(element))
+}
+
+// TryAdd is similar to Add, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (c *Counter) TryAdd(element interface{}) error {
+	x, err := containers.TryValidate(c.datatype, element)
+	if err != nil {
+		return err
+	}
+	c.add(x)
+	return nil
+}
+
+func (c *Counter) add(x containers.Container) {
 	currCounter, found := c._getFromCountMap(x.Key())
 	// Update the count
 	if !found {
@@ -103,15 +184,16 @@ func (c *Counter) Subtract(element interface{}) {
 		currCount--
 		c._storeInCountMap(x.Key(), currCount)
 	}
+	c._storeInObjectMap(x.Key(), x)
 }
 
 // Delete removes an item from the counter map completely. If counter is nil or there is no such element, delete
 // is a no-op.
 func (c *Counter) Delete(element interface{}) {
 	x := c.datatype.Validate(element)
-	c.countMap.Delete(x.Key())
+	_, found := c.countMap.LoadAndDelete(x.Key())
 	c.objectMap.Delete(x.Key())
-	if c.size > 0 {
+	if found {
 		c.size--
 	}
 }
@@ -135,32 +217,45 @@ func (c *Counter) Range(callback func(key interface{}, value int)) {
 	})
 }
 
+// All returns an iterator over the elements of the counter & their counts, in no particular order.
+// Similar to Range, the iteration internally uses sync.Map's Range method, and hence can show inconsistencies
+// during concurrency.
+func (c *Counter) All() iter.Seq2[interface{}, int] {
+	return func(yield func(interface{}, int) bool) {
+		c.countMap.Range(func(key, value interface{}) bool {
+			obj, found := c._getFromObjectMap(key)
+			if !found {
+				return true
+			}
+			return yield(containers.CleanBasicType(obj), value.(int))
+		})
+	}
+}
+
 // MostCommon lists the n most common elements and their counts from the most common to the least.
 // Returns a slice of struct containing the Container and it's count
-// Time Complexity: O(n)
+// Time Complexity: O(m*log(m)), where m is the number of distinct counts
 // Space Complexity: O(n)
 func (c *Counter) MostCommon(n int) map[containers.Container]int {
 	reverseCounterMap := make(map[int][]interface{})
-	counts := make([]interface{}, 0)
+	counts := priority.NewMax(containers.IntContainer(0))
 	c.countMap.Range(func(key, value interface{}) bool {
 		valInt := value.(int)
 		_, found := reverseCounterMap[valInt]
 		if !found {
 			reverseCounterMap[value.(int)] = []interface{}{key}
-			counts = append(counts, valInt)
+			counts.Push(valInt, valInt)
 		} else {
 			reverseCounterMap[valInt] = append(reverseCounterMap[valInt], key)
 		}
 		return true
 	})
-	// Create a Heap
-	heap := heaps.NewMaxInt(counts...)
-	nLargest := heap.NLargest(n)
 	result := make(map[containers.Container]int)
-	for _, count := range nLargest {
-		for _, element := range reverseCounterMap[containers.ToInt(count)] {
+	for i := 0; i < n && !counts.Empty(); i++ {
+		count := counts.Pop().(int)
+		for _, element := range reverseCounterMap[count] {
 			obj, _ := c._getFromObjectMap(element)
-			result[obj] = containers.ToInt(count)
+			result[obj] = count
 		}
 	}
 	return result
@@ -187,3 +282,83 @@ func NewIntCounter(elements ...interface{}) *Counter {
 func NewStringCounter(elements ...interface{}) *Counter {
 	return NewCounter(containers.StringContainer(""), elements...)
 }
+
+// NewFloat64Counter instantiates a counter object with keys as float64 variables
+func NewFloat64Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Float64Container(0), elements...)
+}
+
+// NewInt64Counter instantiates a counter object with keys as int64 variables
+func NewInt64Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Int64Container(0), elements...)
+}
+
+// NewUint64Counter instantiates a counter object with keys as uint64 variables
+func NewUint64Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Uint64Container(0), elements...)
+}
+
+// NewInt8Counter instantiates a counter object with keys as int8 variables
+func NewInt8Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Int8Container(0), elements...)
+}
+
+// NewInt16Counter instantiates a counter object with keys as int16 variables
+func NewInt16Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Int16Container(0), elements...)
+}
+
+// NewInt32Counter instantiates a counter object with keys as int32 variables
+func NewInt32Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Int32Container(0), elements...)
+}
+
+// NewUintCounter instantiates a counter object with keys as uint variables
+func NewUintCounter(elements ...interface{}) *Counter {
+	return NewCounter(containers.UintContainer(0), elements...)
+}
+
+// NewUint8Counter instantiates a counter object with keys as uint8 variables
+func NewUint8Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Uint8Container(0), elements...)
+}
+
+// NewUint16Counter instantiates a counter object with keys as uint16 variables
+func NewUint16Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Uint16Container(0), elements...)
+}
+
+// NewUint32Counter instantiates a counter object with keys as uint32 variables
+func NewUint32Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Uint32Container(0), elements...)
+}
+
+// NewFloat32Counter instantiates a counter object with keys as float32 variables
+func NewFloat32Counter(elements ...interface{}) *Counter {
+	return NewCounter(containers.Float32Container(0), elements...)
+}
+
+// NewRuneCounter instantiates a counter object with keys as rune variables
+func NewRuneCounter(elements ...interface{}) *Counter {
+	return NewCounter(containers.RuneContainer(0), elements...)
+}
+
+// NewBoolCounter instantiates a counter object with keys as bool variables
+func NewBoolCounter(elements ...interface{}) *Counter {
+	return NewCounter(containers.BoolContainer(false), elements...)
+}
+
+// NewTimeCounter instantiates a counter object with keys as time.Time variables
+func NewTimeCounter(elements ...interface{}) *Counter {
+	return NewCounter(containers.TimeContainer{}, elements...)
+}
+
+// NewDurationCounter instantiates a counter object with keys as time.Duration variables
+func NewDurationCounter(elements ...interface{}) *Counter {
+	return NewCounter(containers.DurationContainer(0), elements...)
+}
+
+// NewBytesCounter instantiates a counter object with keys as []byte variables
+func NewBytesCounter(elements ...interface{}) *Counter {
+	return NewCounter(containers.BytesContainer{}, elements...)
+}
@@ -1,6 +1,7 @@
 package counter
 
 import (
+	"github.com/soheltarir/gollections"
 	"github.com/soheltarir/gollections/containers"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -44,6 +45,13 @@ func TestCounter_MostCommon(t *testing.T) {
 	assert.Equal(t, expected, counter.MostCommon(2))
 }
 
+func TestCounter_MostCommon_DistinctCounts(t *testing.T) {
+	counter := NewIntCounter(1, 2, 2, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 5)
+	expected := map[containers.Container]int{containers.IntContainer(5): 5, containers.IntContainer(4): 4}
+	assert.Equal(t, expected, counter.MostCommon(2))
+	assert.Len(t, counter.MostCommon(10), 5)
+}
+
 func TestCounter_Iterator(t *testing.T) {
 	counter := NewStringCounter()
 	arr := []interface{}{"a", "a", "b", "c", "d", "a", "c"}
@@ -59,3 +67,55 @@ func TestCounter_Delete(t *testing.T) {
 	assert.Equal(t, 0, counter.Get("a"))
 	assert.Equal(t, 1, counter.Size())
 }
+
+func TestCounter_TryAdd(t *testing.T) {
+	counter := NewIntCounter()
+	assert.NoError(t, counter.TryAdd(1))
+	var typeErr *containers.TypeError
+	assert.ErrorAs(t, counter.TryAdd("a"), &typeErr)
+	assert.Equal(t, 1, counter.Size())
+}
+
+func TestNewBytesCounter(t *testing.T) {
+	counter := NewBytesCounter([]byte("a"), []byte("a"), []byte("b"))
+	assert.Equal(t, 2, counter.Get([]byte("a")))
+	assert.Equal(t, 2, counter.Size())
+}
+
+func TestCounter_Clear(t *testing.T) {
+	counter := NewStringCounter("a", "b", "b")
+	counter.Subtract("c")
+	assert.ElementsMatch(t, []interface{}{"a", "b", "c"}, counter.ToSlice())
+	counter.Clear()
+	assert.True(t, counter.Empty())
+	assert.Equal(t, 0, counter.Get("b"))
+	// Deleting a missing element should not change the size
+	counter.Add("a")
+	counter.Delete("z")
+	assert.Equal(t, 1, counter.Size())
+}
+
+func TestCounter_All(t *testing.T) {
+	counter := NewStringCounter("a", "a", "b")
+	actual := make(map[interface{}]int)
+	for key, count := range counter.All() {
+		actual[key] = count
+	}
+	assert.Equal(t, map[interface{}]int{"a": 2, "b": 1}, actual)
+
+	var visited int
+	for range counter.All() {
+		visited++
+		break
+	}
+	assert.Equal(t, 1, visited)
+}
+
+func TestCounter_AsCollection(t *testing.T) {
+	c := NewStringCounter("a", "b", "a")
+	var collection gollections.Collection = c.AsCollection()
+	assert.Equal(t, int64(2), collection.Size())
+	assert.ElementsMatch(t, []interface{}{"a", "b"}, collection.ToSlice())
+	collection.Clear()
+	assert.Equal(t, 0, c.Size())
+}
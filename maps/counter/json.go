@@ -0,0 +1,154 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package counter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+	"sort"
+)
+
+// encodedEntry is an element of the counter along with its count, where the element is JSON encoded.
+type encodedEntry struct {
+	element json.RawMessage
+	count   int
+}
+
+// MarshalJSON implements json.Marshaler. The counter is encoded as a JSON object mapping the elements to their
+// counts when every element encodes to a JSON string, number or boolean, e.g., {"a": 2, "b": 1}. Otherwise, e.g.,
+// for struct elements, the counter is encoded as an array of [element, count] pairs, e.g., [[{"ID": "1"}, 2]].
+// The entries are sorted by the encoded elements, hence the output is deterministic.
+func (c *Counter) MarshalJSON() ([]byte, error) {
+	entries := make([]encodedEntry, 0, c.Size())
+	objectForm := true
+	for element, count := range c.All() {
+		encoded, err := json.Marshal(element)
+		if err != nil {
+			return nil, err
+		}
+		if !isScalar(encoded) {
+			objectForm = false
+		}
+		entries = append(entries, encodedEntry{element: encoded, count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].element, entries[j].element) < 0
+	})
+
+	if !objectForm {
+		pairs := make([][2]interface{}, 0, len(entries))
+		for _, entry := range entries {
+			pairs = append(pairs, [2]interface{}{entry.element, entry.count})
+		}
+		return json.Marshal(pairs)
+	}
+	object := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		var key string
+		// JSON strings are used as is, while numbers & booleans are used in their encoded form
+		if err := json.Unmarshal(entry.element, &key); err != nil {
+			key = string(entry.element)
+		}
+		object[key] = entry.count
+	}
+	return json.Marshal(object)
+}
+
+// UnmarshalJSON implements json.Unmarshaler; decodes either of the forms produced by MarshalJSON, i.e., a JSON
+// object of element-count entries or an array of [element, count] pairs, replacing the current contents of the
+// counter. The elements are decoded into the datatype the counter was initialised with, hence the counter must be
+// created using NewCounter (or one of the NewXCounter constructors) beforehand.
+// The counter is left unmodified in case of an error.
+func (c *Counter) UnmarshalJSON(data []byte) error {
+	if c.datatype == nil {
+		return containers.ErrNoValueType
+	}
+	var (
+		elements []containers.Container
+		counts   []int
+	)
+	switch trimmed := bytes.TrimSpace(data); {
+	case len(trimmed) > 0 && trimmed[0] == '{':
+		var object map[string]int
+		if err := json.Unmarshal(trimmed, &object); err != nil {
+			return err
+		}
+		for key, count := range object {
+			element, err := c.decodeObjectKey(key)
+			if err != nil {
+				return err
+			}
+			elements, counts = append(elements, element), append(counts, count)
+		}
+	default:
+		var pairs [][]json.RawMessage
+		if err := json.Unmarshal(trimmed, &pairs); err != nil {
+			return err
+		}
+		for _, pair := range pairs {
+			if len(pair) != 2 {
+				return fmt.Errorf("invalid counter entry; expected an [element, count] pair, received %d values",
+					len(pair))
+			}
+			element, err := containers.UnmarshalJSON(c.datatype, pair[0])
+			if err != nil {
+				return err
+			}
+			var count int
+			if err := json.Unmarshal(pair[1], &count); err != nil {
+				return err
+			}
+			elements, counts = append(elements, element), append(counts, count)
+		}
+	}
+
+	c.replace(elements, counts)
+	return nil
+}
+
+// decodeObjectKey decodes a key of the JSON object form into the counter's datatype. The key is first decoded as
+// a JSON string (e.g. string, time.Time elements), and then as a raw JSON value (e.g. numeric, boolean elements).
+func (c *Counter) decodeObjectKey(key string) (containers.Container, error) {
+	quoted, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	if element, err := containers.UnmarshalJSON(c.datatype, quoted); err == nil {
+		return element, nil
+	}
+	return containers.UnmarshalJSON(c.datatype, []byte(key))
+}
+
+// isScalar reports whether the JSON encoded value is a string, number or boolean.
+func isScalar(encoded []byte) bool {
+	switch encoded[0] {
+	case '{', '[', 'n':
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,101 @@
+package counter
+
+import (
+	"encoding/json"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type item struct {
+	ID   int
+	Name string
+}
+
+func (i item) Key() interface{} {
+	return i.ID
+}
+
+func (i item) Less(x containers.Container) bool {
+	return i.ID < x.(item).ID
+}
+
+func (i item) Validate(x interface{}) containers.Container {
+	return x.(item)
+}
+
+func TestCounter_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(NewStringCounter("b", "a", "b"))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":2}`, string(data))
+
+	data, err = json.Marshal(NewIntCounter(10, 2, 2))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"10":1,"2":2}`, string(data))
+
+	data, err = json.Marshal(NewIntCounter())
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	// Non scalar elements are encoded as [element, count] pairs
+	data, err = json.Marshal(NewCounter(item{}, item{ID: 2, Name: "b"}, item{ID: 1, Name: "a"}, item{ID: 1, Name: "a"}))
+	assert.NoError(t, err)
+	assert.Equal(t, `[[{"ID":1,"Name":"a"},2],[{"ID":2,"Name":"b"},1]]`, string(data))
+}
+
+func TestCounter_UnmarshalJSON(t *testing.T) {
+	c := NewStringCounter("stale")
+	assert.NoError(t, json.Unmarshal([]byte(`{"a": 1, "b": 2}`), c))
+	assert.Equal(t, 2, c.Size())
+	assert.Equal(t, 1, c.Get("a"))
+	assert.Equal(t, 2, c.Get("b"))
+	assert.Equal(t, 0, c.Get("stale"))
+
+	// Keys resembling other JSON values are still decoded as strings
+	assert.NoError(t, json.Unmarshal([]byte(`{"1": 1, "true": 2}`), c))
+	assert.Equal(t, 1, c.Get("1"))
+	assert.Equal(t, 2, c.Get("true"))
+
+	// Pair form
+	assert.NoError(t, json.Unmarshal([]byte(`[["x", 3], ["y", -1]]`), c))
+	assert.Equal(t, 2, c.Size())
+	assert.Equal(t, 3, c.Get("x"))
+	assert.Equal(t, -1, c.Get("y"))
+
+	ints := NewIntCounter()
+	assert.NoError(t, json.Unmarshal([]byte(`{"10": 1, "2": 2}`), ints))
+	assert.Equal(t, 1, ints.Get(10))
+	assert.Equal(t, 2, ints.Get(2))
+
+	items := NewCounter(item{})
+	assert.NoError(t, json.Unmarshal([]byte(`[[{"ID":1,"Name":"a"},2]]`), items))
+	assert.Equal(t, 2, items.Get(item{ID: 1}))
+	for element := range items.All() {
+		assert.Equal(t, item{ID: 1, Name: "a"}, element)
+	}
+}
+
+func TestCounter_UnmarshalJSON_Invalid(t *testing.T) {
+	c := NewIntCounter(1)
+	assert.Error(t, json.Unmarshal([]byte(`{"one": 1}`), c))
+	assert.Error(t, json.Unmarshal([]byte(`[[1, 2, 3]]`), c))
+	assert.Error(t, json.Unmarshal([]byte(`[[1, "two"]]`), c))
+	assert.Error(t, json.Unmarshal([]byte(`"one"`), c))
+	// The counter is left unmodified
+	assert.Equal(t, 1, c.Get(1))
+	assert.Equal(t, 1, c.Size())
+
+	var zero Counter
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{}`), &zero), containers.ErrNoValueType)
+}
+
+func TestCounter_JSONRoundTrip(t *testing.T) {
+	original := NewFloat64Counter(1.5, 1.5, -2.25)
+	data, err := json.Marshal(original)
+	assert.NoError(t, err)
+	decoded := NewFloat64Counter()
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, 2, decoded.Get(1.5))
+	assert.Equal(t, 1, decoded.Get(-2.25))
+	assert.Equal(t, original.Size(), decoded.Size())
+}
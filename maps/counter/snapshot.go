@@ -0,0 +1,109 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package counter
+
+import (
+	"bytes"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/snapshot"
+	"io"
+)
+
+// Snapshot writes the elements of the counter along with their counts, in no particular order, in the binary format
+// of the snapshot package. Similar to Range, the counter is read using sync.Map's Range method, hence concurrent
+// updates may or may not be reflected in the snapshot.
+func (c *Counter) Snapshot(w io.Writer) error {
+	var (
+		elements []containers.Container
+		counts   []int
+	)
+	c.countMap.Range(func(key, value interface{}) bool {
+		if obj, found := c._getFromObjectMap(key); found {
+			elements, counts = append(elements, obj), append(counts, value.(int))
+		}
+		return true
+	})
+
+	encoder := snapshot.NewEncoder(w, snapshot.KindCounter, int64(len(elements)))
+	for i, element := range elements {
+		if err := encoder.WriteElement(element); err != nil {
+			return err
+		}
+		if err := encoder.WriteVarint(int64(counts[i])); err != nil {
+			return err
+		}
+	}
+	return encoder.Close()
+}
+
+// Restore reads a snapshot written by Snapshot, replacing the current contents of the counter. The elements are
+// decoded into the datatype the counter was initialised with, hence the counter must be created using NewCounter
+// (or one of the NewXCounter constructors) beforehand. Returns snapshot.ErrTruncated or snapshot.ErrChecksumMismatch
+// if the snapshot is incomplete or corrupted, in which case the counter is left unmodified.
+func (c *Counter) Restore(r io.Reader) error {
+	if c.datatype == nil {
+		return containers.ErrNoValueType
+	}
+	decoder, err := snapshot.NewDecoder(r, snapshot.KindCounter)
+	if err != nil {
+		return err
+	}
+	var (
+		elements []containers.Container
+		counts   []int
+	)
+	for i := int64(0); i < decoder.Count; i++ {
+		element, err := decoder.ReadElement(c.datatype)
+		if err != nil {
+			return err
+		}
+		count, err := decoder.ReadVarint()
+		if err != nil {
+			return err
+		}
+		elements, counts = append(elements, element), append(counts, int(count))
+	}
+	if err := decoder.Close(); err != nil {
+		return err
+	}
+
+	c.replace(elements, counts)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the binary format written by Snapshot.
+func (c *Counter) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := c.Snapshot(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder; similar to Restore, the counter must be created using NewCounter (or one of
+// the NewXCounter constructors) beforehand.
+func (c *Counter) GobDecode(data []byte) error {
+	return c.Restore(bytes.NewReader(data))
+}
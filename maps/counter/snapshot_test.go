@@ -0,0 +1,73 @@
+package counter
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/snapshot"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCounter_Snapshot(t *testing.T) {
+	c := NewStringCounter("a", "b", "a", "c")
+	c.Subtract("d")
+	var buf bytes.Buffer
+	assert.NoError(t, c.Snapshot(&buf))
+
+	restored := NewStringCounter("stale")
+	assert.NoError(t, restored.Restore(&buf))
+	assert.Equal(t, 4, restored.Size())
+	assert.Equal(t, 2, restored.Get("a"))
+	assert.Equal(t, 1, restored.Get("c"))
+	assert.Equal(t, -1, restored.Get("d"))
+	assert.Equal(t, 0, restored.Get("stale"))
+}
+
+func TestCounter_Snapshot_Struct(t *testing.T) {
+	c := NewCounter(item{}, item{ID: 1, Name: "a"}, item{ID: 1, Name: "a"}, item{ID: 2, Name: "b"})
+	var buf bytes.Buffer
+	assert.NoError(t, c.Snapshot(&buf))
+
+	restored := NewCounter(item{})
+	assert.NoError(t, restored.Restore(&buf))
+	assert.Equal(t, 2, restored.Get(item{ID: 1}))
+	assert.ElementsMatch(t, c.ToSlice(), restored.ToSlice())
+}
+
+func TestCounter_Restore_Corrupted(t *testing.T) {
+	c := NewIntCounter(1, 1, 2)
+	var buf bytes.Buffer
+	assert.NoError(t, c.Snapshot(&buf))
+	data := buf.Bytes()
+
+	restored := NewIntCounter(5)
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(data[:len(data)-3])), snapshot.ErrTruncated)
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(corrupted)), snapshot.ErrChecksumMismatch)
+	// A list snapshot can't be restored into a counter
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(append([]byte("GOLS"), 1, 1, 0))), snapshot.ErrInvalidFormat)
+	// The counter is left unmodified
+	assert.Equal(t, 1, restored.Get(5))
+	assert.Equal(t, 1, restored.Size())
+
+	var zero Counter
+	assert.ErrorIs(t, zero.Restore(bytes.NewReader(data)), containers.ErrNoValueType)
+}
+
+func TestCounter_Gob(t *testing.T) {
+	c := NewIntCounter()
+	for i := 0; i < 1000; i++ {
+		c.Add(i % 10)
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(c))
+
+	decoded := NewIntCounter()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	assert.Equal(t, 10, decoded.Size())
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, 100, decoded.Get(i))
+	}
+}
@@ -0,0 +1,50 @@
+package queue
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"testing"
+)
+
+const benchmarkSize = 10000
+
+func benchmarkQueue(b *testing.B, constructor func(containers.Container, ...interface{}) *Queue) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		q := constructor(containers.IntContainer(0))
+		for j := 0; j < benchmarkSize; j++ {
+			q.Enqueue(j)
+		}
+		for !q.Empty() {
+			q.Dequeue()
+		}
+	}
+}
+
+func benchmarkQueueSteady(b *testing.B, constructor func(containers.Container, ...interface{}) *Queue) {
+	q := constructor(containers.IntContainer(0))
+	for j := 0; j < 64; j++ {
+		q.Enqueue(j)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.Enqueue(i)
+		q.Dequeue()
+	}
+}
+
+func BenchmarkQueue_EnqueueDequeue(b *testing.B) {
+	benchmarkQueue(b, New)
+}
+
+func BenchmarkQueueWithDeque_EnqueueDequeue(b *testing.B) {
+	benchmarkQueue(b, NewWithDeque)
+}
+
+func BenchmarkQueue_Steady(b *testing.B) {
+	benchmarkQueueSteady(b, New)
+}
+
+func BenchmarkQueueWithDeque_Steady(b *testing.B) {
+	benchmarkQueueSteady(b, NewWithDeque)
+}
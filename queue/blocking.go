@@ -0,0 +1,233 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by the operations of a BlockingQueue once it has been closed.
+var ErrClosed = errors.New("queue is closed")
+
+// ErrTimeout is returned by Offer & Poll when the timeout elapses before the operation could be performed.
+var ErrTimeout = errors.New("queue operation timed out")
+
+// A BlockingQueue is a FIFO queue, optionally bounded to a capacity, whose operations wait for the queue to become
+// non-empty when retrieving an element, and wait for space to become available when adding an element.
+//
+// Once closed, elements can no longer be added, while the elements already queued can still be retrieved; the
+// operations waiting on the queue (and the later ones which would wait) fail with ErrClosed.
+type BlockingQueue struct {
+	data      *Queue
+	valueType containers.Container
+	// capacity is the maximum number of elements in the queue, zero for an unbounded queue
+	capacity int64
+	closed   bool
+	mu       sync.Mutex
+	// changed is closed (and replaced) to wake the waiting operations whenever an element is added or removed, or
+	// the queue is closed
+	changed chan struct{}
+	waiting int
+}
+
+// Put adds the value at the end of the queue, waiting for space to become available if the queue is at its capacity.
+// Returns ErrClosed if the queue is closed, the context's error if the context is done before the value could be
+// added, or a *containers.TypeError if an invalid type is provided.
+func (q *BlockingQueue) Put(ctx context.Context, value interface{}) error {
+	if _, err := containers.TryValidate(q.valueType, value); err != nil {
+		return err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if q.closed {
+			return ErrClosed
+		}
+		if q.capacity == 0 || q.data.Size() < q.capacity {
+			q.data.Enqueue(value)
+			q.signal()
+			return nil
+		}
+		if err := q.wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// Take removes & returns the element at the front of the queue, waiting for an element to arrive if the queue is
+// empty. Returns ErrClosed if the queue is closed & empty, or the context's error if the context is done before an
+// element arrives.
+func (q *BlockingQueue) Take(ctx context.Context) (interface{}, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if !q.data.Empty() {
+			value := q.data.Dequeue()
+			q.signal()
+			return value, nil
+		}
+		if q.closed {
+			return nil, ErrClosed
+		}
+		if err := q.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Offer is similar to Put, but waits for at most the timeout provided, returning ErrTimeout if no space became
+// available. A non-positive timeout doesn't wait at all.
+func (q *BlockingQueue) Offer(value interface{}, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := q.Put(ctx, value)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimeout
+	}
+	return err
+}
+
+// Poll is similar to Take, but waits for at most the timeout provided, returning ErrTimeout if no element arrived.
+// A non-positive timeout doesn't wait at all.
+func (q *BlockingQueue) Poll(timeout time.Duration) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	value, err := q.Take(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, ErrTimeout
+	}
+	return value, err
+}
+
+// Close closes the queue, waking all the operations waiting on it. Elements can no longer be added to the queue,
+// whereas the elements already queued can still be retrieved. Closing a closed queue has no effect.
+func (q *BlockingQueue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.closed {
+		q.closed = true
+		q.signal()
+	}
+	return nil
+}
+
+// Closed reports whether the queue has been closed.
+func (q *BlockingQueue) Closed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
+
+// Capacity returns the maximum number of elements in the queue, or zero if the queue is unbounded.
+func (q *BlockingQueue) Capacity() int64 {
+	return q.capacity
+}
+
+// Size returns the number of elements in the queue
+func (q *BlockingQueue) Size() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.data.Size()
+}
+
+// Empty returns true if the queue has no elements
+func (q *BlockingQueue) Empty() bool {
+	return q.Size() == 0
+}
+
+// Clear empties the queue, waking the operations waiting for space to become available.
+func (q *BlockingQueue) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.data.Clear()
+	q.signal()
+}
+
+// ToSlice returns the elements of the queue from the front to the back.
+func (q *BlockingQueue) ToSlice() []interface{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.data.ToSlice()
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the queue from the front to the back.
+func (q *BlockingQueue) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(q.ToSlice())
+}
+
+// wait waits until the queue changes or the context is done. The caller must hold the queue's lock, which is
+// released while waiting.
+func (q *BlockingQueue) wait(ctx context.Context) error {
+	changed := q.changed
+	q.waiting++
+	q.mu.Unlock()
+
+	var err error
+	select {
+	case <-changed:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	q.mu.Lock()
+	q.waiting--
+	return err
+}
+
+// signal wakes the waiting operations to re-check the queue. The caller must hold the queue's lock.
+func (q *BlockingQueue) signal() {
+	if q.waiting > 0 {
+		close(q.changed)
+		q.changed = make(chan struct{})
+	}
+}
+
+// NewBlocking instantiates a new blocking queue of the Container type provided, bounded to the capacity provided.
+// A zero capacity creates an unbounded queue. Panics if the capacity is negative.
+func NewBlocking(valueType containers.Container, capacity int64) *BlockingQueue {
+	if capacity < 0 {
+		panic(fmt.Sprintf("queue capacity cannot be negative, received: %d", capacity))
+	}
+	return &BlockingQueue{
+		data:      New(valueType),
+		valueType: valueType,
+		capacity:  capacity,
+		changed:   make(chan struct{}),
+	}
+}
@@ -0,0 +1,266 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"github.com/soheltarir/gollections/containers"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewBlocking(t *testing.T) {
+	q := NewBlocking(containers.IntContainer(0), 2)
+	if q.Capacity() != 2 {
+		t.Errorf("Got %d, expected 2", q.Capacity())
+	}
+	if !q.Empty() || q.Closed() {
+		t.Errorf("Queue should be empty & open")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic for a negative capacity")
+		}
+	}()
+	NewBlocking(containers.IntContainer(0), -1)
+}
+
+func TestBlockingQueue_PutTake(t *testing.T) {
+	q := NewBlocking(containers.IntContainer(0), 0)
+	ctx := context.Background()
+	for i := 1; i <= 3; i++ {
+		if err := q.Put(ctx, i); err != nil {
+			t.Errorf("Got error %v, expected nil", err)
+		}
+	}
+	if q.Size() != 3 {
+		t.Errorf("Got %d, expected 3", q.Size())
+	}
+	for i := 1; i <= 3; i++ {
+		if value, err := q.Take(ctx); err != nil || value != i {
+			t.Errorf("Got (%v, %v), expected (%d, nil)", value, err, i)
+		}
+	}
+	var typeErr *containers.TypeError
+	if err := q.Put(ctx, "1"); !errors.As(err, &typeErr) {
+		t.Errorf("Got %v, expected a type error", err)
+	}
+}
+
+func TestBlockingQueue_TakeWaits(t *testing.T) {
+	q := NewBlocking(containers.IntContainer(0), 1)
+	result := make(chan interface{})
+	go func() {
+		value, _ := q.Take(context.Background())
+		result <- value
+	}()
+	select {
+	case <-result:
+		t.Fatalf("Take returned from an empty queue")
+	case <-time.After(20 * time.Millisecond):
+	}
+	if err := q.Put(context.Background(), 1); err != nil {
+		t.Errorf("Got error %v, expected nil", err)
+	}
+	if value := <-result; value != 1 {
+		t.Errorf("Got %v, expected 1", value)
+	}
+}
+
+func TestBlockingQueue_PutWaits(t *testing.T) {
+	q := NewBlocking(containers.IntContainer(0), 1)
+	_ = q.Put(context.Background(), 1)
+	result := make(chan error)
+	go func() {
+		result <- q.Put(context.Background(), 2)
+	}()
+	select {
+	case <-result:
+		t.Fatalf("Put returned while the queue is at its capacity")
+	case <-time.After(20 * time.Millisecond):
+	}
+	if value, _ := q.Take(context.Background()); value != 1 {
+		t.Errorf("Got %v, expected 1", value)
+	}
+	if err := <-result; err != nil {
+		t.Errorf("Got error %v, expected nil", err)
+	}
+	if values := q.ToSlice(); len(values) != 1 || values[0] != 2 {
+		t.Errorf("Got %v, expected [2]", values)
+	}
+
+	// Clearing the queue makes space as well
+	go func() {
+		result <- q.Put(context.Background(), 3)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	q.Clear()
+	if err := <-result; err != nil {
+		t.Errorf("Got error %v, expected nil", err)
+	}
+	if it := q.Iter(); !it.HasNext() || it.Next() != 3 {
+		t.Errorf("Expected the queue to contain 3")
+	}
+}
+
+func TestBlockingQueue_Context(t *testing.T) {
+	q := NewBlocking(containers.IntContainer(0), 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := q.Take(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Got %v, expected context.Canceled", err)
+	}
+	// The elements available are returned regardless of the context
+	if err := q.Put(ctx, 1); err != nil {
+		t.Errorf("Got error %v, expected nil", err)
+	}
+	if err := q.Put(ctx, 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("Got %v, expected context.Canceled", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := q.Put(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Got %v, expected context.DeadlineExceeded", err)
+	}
+}
+
+func TestBlockingQueue_OfferPoll(t *testing.T) {
+	q := NewBlocking(containers.IntContainer(0), 1)
+	if _, err := q.Poll(0); !errors.Is(err, ErrTimeout) {
+		t.Errorf("Got %v, expected ErrTimeout", err)
+	}
+	if err := q.Offer(1, 0); err != nil {
+		t.Errorf("Got error %v, expected nil", err)
+	}
+	start := time.Now()
+	if err := q.Offer(2, 20*time.Millisecond); !errors.Is(err, ErrTimeout) {
+		t.Errorf("Got %v, expected ErrTimeout", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Offer returned after %v, expected to wait for the timeout", elapsed)
+	}
+	if value, err := q.Poll(time.Second); err != nil || value != 1 {
+		t.Errorf("Got (%v, %v), expected (1, nil)", value, err)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = q.Offer(3, 0)
+	}()
+	if value, err := q.Poll(time.Second); err != nil || value != 3 {
+		t.Errorf("Got (%v, %v), expected (3, nil)", value, err)
+	}
+}
+
+func TestBlockingQueue_Close(t *testing.T) {
+	q := NewBlocking(containers.IntContainer(0), 1)
+	_ = q.Put(context.Background(), 1)
+
+	// Waiters on either side are woken up
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- q.Put(context.Background(), 2)
+		}()
+	}
+	empty := NewBlocking(containers.IntContainer(0), 0)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := empty.Take(context.Background())
+			errs <- err
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	_ = q.Close()
+	_ = empty.Close()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if !errors.Is(err, ErrClosed) {
+			t.Errorf("Got %v, expected ErrClosed", err)
+		}
+	}
+
+	// The queued elements can still be retrieved
+	if !q.Closed() {
+		t.Errorf("Queue should be closed")
+	}
+	if err := q.Offer(2, 0); !errors.Is(err, ErrClosed) {
+		t.Errorf("Got %v, expected ErrClosed", err)
+	}
+	if value, err := q.Take(context.Background()); err != nil || value != 1 {
+		t.Errorf("Got (%v, %v), expected (1, nil)", value, err)
+	}
+	if _, err := q.Poll(time.Second); !errors.Is(err, ErrClosed) {
+		t.Errorf("Got %v, expected ErrClosed", err)
+	}
+	if err := q.Close(); err != nil {
+		t.Errorf("Got error %v, expected nil", err)
+	}
+}
+
+func TestBlockingQueue_ProducerConsumer(t *testing.T) {
+	const producers, consumers, items = 4, 4, 500
+	q := NewBlocking(containers.IntContainer(0), 8)
+	ctx := context.Background()
+
+	var producersWg, consumersWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersWg.Add(1)
+		go func(p int) {
+			defer producersWg.Done()
+			for i := 0; i < items; i++ {
+				if err := q.Put(ctx, p*items+i); err != nil {
+					t.Errorf("Got error %v, expected nil", err)
+				}
+				if size := q.Size(); size > q.Capacity() {
+					t.Errorf("Got size %d, exceeding the capacity", size)
+				}
+			}
+		}(p)
+	}
+
+	received := make([][]int, consumers)
+	for c := 0; c < consumers; c++ {
+		consumersWg.Add(1)
+		go func(c int) {
+			defer consumersWg.Done()
+			for {
+				value, err := q.Take(ctx)
+				if errors.Is(err, ErrClosed) {
+					return
+				}
+				received[c] = append(received[c], value.(int))
+			}
+		}(c)
+	}
+	producersWg.Wait()
+	_ = q.Close()
+	consumersWg.Wait()
+
+	seen := make(map[int]bool)
+	for _, values := range received {
+		last := make(map[int]int)
+		for _, value := range values {
+			if seen[value] {
+				t.Errorf("Received %d more than once", value)
+			}
+			seen[value] = true
+			// The values of each producer are received in order by each consumer
+			producer := value / items
+			if previous, ok := last[producer]; ok && previous > value {
+				t.Errorf("Received %d after %d", value, previous)
+			}
+			last[producer] = value
+		}
+	}
+	if len(seen) != producers*items {
+		t.Errorf("Received %d values, expected %d", len(seen), producers*items)
+	}
+}
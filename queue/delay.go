@@ -0,0 +1,230 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package queue
+
+import (
+	"context"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/trees/heaps"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time & timers to a DelayQueue. Tests can provide their own Clock to control the passage
+// of time deterministically instead of sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel receiving the current time once the duration has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock reading the system time
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SystemClock is the Clock used by the delay queues created using NewDelay, reading the system time.
+var SystemClock Clock = systemClock{}
+
+// delayed is an element of a DelayQueue, ordered by the time it becomes due, and the elements due at the same time
+// in the order they were put
+type delayed struct {
+	value    containers.Container
+	due      time.Time
+	sequence uint64
+}
+
+func (d *delayed) Key() interface{} {
+	return d
+}
+
+func (d *delayed) Less(other containers.Container) bool {
+	o := other.(*delayed)
+	if d.due.Equal(o.due) {
+		return d.sequence < o.sequence
+	}
+	return d.due.Before(o.due)
+}
+
+func (d *delayed) Validate(x interface{}) containers.Container {
+	return x.(*delayed)
+}
+
+// A DelayQueue is an unbounded queue whose elements are put along with a delay, and can only be taken once their
+// delay has elapsed. The elements are taken in the order they become due; elements due at the same time are taken
+// in the order they were put.
+type DelayQueue struct {
+	// data holds the elements ordered by the time they become due
+	data      *heaps.MinHeap
+	valueType containers.Container
+	sequence  uint64
+	clock     Clock
+	mu        sync.Mutex
+	// changed is closed (and replaced) to wake the waiting operations whenever an element is added, since it might
+	// become due earlier than the element they were waiting for
+	changed chan struct{}
+	waiting int
+}
+
+// Put adds the value to the queue, to be available once the delay has elapsed. A non-positive delay makes the value
+// available immediately. Returns a *containers.TypeError if an invalid type is provided.
+func (q *DelayQueue) Put(value interface{}, delay time.Duration) error {
+	element, err := containers.TryValidate(q.valueType, value)
+	if err != nil {
+		return err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.data.Insert(&delayed{value: element, due: q.clock.Now().Add(delay), sequence: q.sequence})
+	q.sequence++
+	q.signal()
+	return nil
+}
+
+// Take removes & returns the element due first, waiting for it to become due, or for an element to arrive if the
+// queue is empty. Returns the context's error if the context is done before an element is due.
+func (q *DelayQueue) Take(ctx context.Context) (interface{}, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		var timer <-chan time.Time
+		if !q.data.Empty() {
+			delay := q.data.Peek().(*delayed).due.Sub(q.clock.Now())
+			if delay <= 0 {
+				return q.pop(), nil
+			}
+			timer = q.clock.After(delay)
+		}
+		if err := q.wait(ctx, timer); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Drain removes & returns all the elements which are due, in the order they became due, without waiting.
+func (q *DelayQueue) Drain() []interface{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.clock.Now()
+	values := make([]interface{}, 0)
+	for !q.data.Empty() && !q.data.Peek().(*delayed).due.After(now) {
+		values = append(values, q.pop())
+	}
+	return values
+}
+
+// Size returns the number of elements in the queue, including the elements which are not due yet.
+func (q *DelayQueue) Size() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.data.Size()
+}
+
+// Empty returns true if the queue has no elements
+func (q *DelayQueue) Empty() bool {
+	return q.Size() == 0
+}
+
+// Clear removes all the elements from the queue.
+func (q *DelayQueue) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.data.Clear()
+}
+
+// ToSlice returns the elements of the queue, including the elements which are not due yet, in the heap's internal
+// order, i.e., the element due first is the first element, but the remaining elements are not sorted.
+func (q *DelayQueue) ToSlice() []interface{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	values := q.data.ToSlice()
+	for i, element := range values {
+		values[i] = containers.CleanBasicType(element.(*delayed).value)
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the queue in the heap's internal order.
+func (q *DelayQueue) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(q.ToSlice())
+}
+
+// pop removes & returns the value of the element due first. The caller must hold the queue's lock.
+func (q *DelayQueue) pop() interface{} {
+	return containers.CleanBasicType(q.data.Extract().(*delayed).value)
+}
+
+// wait waits until an element is added to the queue, the timer fires or the context is done. A nil timer never
+// fires. The caller must hold the queue's lock, which is released while waiting.
+func (q *DelayQueue) wait(ctx context.Context, timer <-chan time.Time) error {
+	changed := q.changed
+	q.waiting++
+	q.mu.Unlock()
+
+	var err error
+	select {
+	case <-changed:
+	case <-timer:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	q.mu.Lock()
+	q.waiting--
+	return err
+}
+
+// signal wakes the waiting operations to re-check the queue. The caller must hold the queue's lock.
+func (q *DelayQueue) signal() {
+	if q.waiting > 0 {
+		close(q.changed)
+		q.changed = make(chan struct{})
+	}
+}
+
+// NewDelay instantiates a new delay queue of the Container type provided, using the system time.
+func NewDelay(valueType containers.Container) *DelayQueue {
+	return NewDelayWithClock(valueType, SystemClock)
+}
+
+// NewDelayWithClock instantiates a new delay queue of the Container type provided, reading the time from the Clock
+// provided.
+func NewDelayWithClock(valueType containers.Container, clock Clock) *DelayQueue {
+	return &DelayQueue{
+		data:      heaps.NewMin(&delayed{}),
+		valueType: valueType,
+		clock:     clock,
+		changed:   make(chan struct{}),
+	}
+}
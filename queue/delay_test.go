@@ -0,0 +1,245 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"github.com/soheltarir/gollections/containers"
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose time only moves when advanced, firing the timers which became due
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []fakeTimer
+}
+
+type fakeTimer struct {
+	deadline time.Time
+	c        chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := fakeTimer{deadline: c.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		timer.c <- c.now
+	} else {
+		c.timers = append(c.timers, timer)
+	}
+	return timer.c
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.deadline.After(c.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.c <- c.now
+		}
+	}
+	c.timers = pending
+}
+
+// awaitTimers waits until n timers are pending, i.e., until the waiting operations started their timers
+func (c *fakeClock) awaitTimers(n int) {
+	for {
+		c.mu.Lock()
+		pending := len(c.timers)
+		c.mu.Unlock()
+		if pending >= n {
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestDelayQueue_Drain(t *testing.T) {
+	clock := newFakeClock()
+	q := NewDelayWithClock(containers.StringContainer(""), clock)
+	_ = q.Put("c", 3*time.Second)
+	_ = q.Put("a", time.Second)
+	_ = q.Put("b", 2*time.Second)
+	_ = q.Put("b2", 2*time.Second)
+
+	if values := q.Drain(); len(values) != 0 {
+		t.Errorf("Got %v, expected no due elements", values)
+	}
+	clock.Advance(2 * time.Second)
+	if values := q.Drain(); !reflect.DeepEqual(values, []interface{}{"a", "b", "b2"}) {
+		t.Errorf("Got %v, expected [a b b2]", values)
+	}
+	if q.Size() != 1 {
+		t.Errorf("Got %d, expected 1", q.Size())
+	}
+	clock.Advance(time.Second)
+	if values := q.Drain(); !reflect.DeepEqual(values, []interface{}{"c"}) {
+		t.Errorf("Got %v, expected [c]", values)
+	}
+	if !q.Empty() {
+		t.Errorf("Got %v, expected an empty queue", q.ToSlice())
+	}
+}
+
+func TestDelayQueue_Put(t *testing.T) {
+	q := NewDelayWithClock(containers.IntContainer(0), newFakeClock())
+	var typeError *containers.TypeError
+	if err := q.Put("a", 0); !errors.As(err, &typeError) {
+		t.Errorf("Got %v, expected a type error", err)
+	}
+	if err := q.Put(1, -time.Second); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	value, err := q.Take(context.Background())
+	if err != nil || value != 1 {
+		t.Errorf("Got %v (%v), expected 1", value, err)
+	}
+}
+
+func TestDelayQueue_Take(t *testing.T) {
+	clock := newFakeClock()
+	q := NewDelayWithClock(containers.IntContainer(0), clock)
+	_ = q.Put(1, time.Minute)
+
+	result := make(chan interface{})
+	go func() {
+		value, _ := q.Take(context.Background())
+		result <- value
+	}()
+	clock.awaitTimers(1)
+	clock.Advance(30 * time.Second)
+	select {
+	case value := <-result:
+		t.Fatalf("Got %v before the element was due", value)
+	default:
+	}
+	clock.Advance(30 * time.Second)
+	if value := <-result; value != 1 {
+		t.Errorf("Got %v, expected 1", value)
+	}
+}
+
+func TestDelayQueue_Take_Empty(t *testing.T) {
+	clock := newFakeClock()
+	q := NewDelayWithClock(containers.IntContainer(0), clock)
+
+	result := make(chan interface{})
+	go func() {
+		value, _ := q.Take(context.Background())
+		result <- value
+	}()
+	// The waiting Take is woken by the new element, and waits for it to become due
+	for {
+		q.mu.Lock()
+		waiting := q.waiting
+		q.mu.Unlock()
+		if waiting > 0 {
+			break
+		}
+		runtime.Gosched()
+	}
+	_ = q.Put(1, time.Second)
+	clock.awaitTimers(1)
+	clock.Advance(time.Second)
+	if value := <-result; value != 1 {
+		t.Errorf("Got %v, expected 1", value)
+	}
+}
+
+func TestDelayQueue_Take_EarlierElement(t *testing.T) {
+	clock := newFakeClock()
+	q := NewDelayWithClock(containers.IntContainer(0), clock)
+	_ = q.Put(2, time.Hour)
+
+	result := make(chan interface{})
+	go func() {
+		value, _ := q.Take(context.Background())
+		result <- value
+	}()
+	clock.awaitTimers(1)
+	_ = q.Put(1, time.Second)
+	clock.awaitTimers(2)
+	clock.Advance(time.Second)
+	if value := <-result; value != 1 {
+		t.Errorf("Got %v, expected 1", value)
+	}
+	if q.Size() != 1 {
+		t.Errorf("Got %d, expected 1", q.Size())
+	}
+}
+
+func TestDelayQueue_Take_Context(t *testing.T) {
+	q := NewDelayWithClock(containers.IntContainer(0), newFakeClock())
+	_ = q.Put(1, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := q.Take(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Got %v, expected %v", err, context.Canceled)
+	}
+	if q.Size() != 1 {
+		t.Errorf("Got %d, expected 1", q.Size())
+	}
+}
+
+func TestDelayQueue_ToSlice(t *testing.T) {
+	q := NewDelayWithClock(containers.IntContainer(0), newFakeClock())
+	_ = q.Put(2, 2*time.Second)
+	_ = q.Put(1, time.Second)
+	_ = q.Put(3, 3*time.Second)
+
+	values := q.ToSlice()
+	if len(values) != 3 || values[0] != 1 {
+		t.Errorf("Got %v, expected 3 values starting with 1", values)
+	}
+	count := 0
+	for it := q.Iter(); it.HasNext(); {
+		it.Next()
+		count++
+	}
+	if count != 3 {
+		t.Errorf("Got %d, expected 3 iterated values", count)
+	}
+	q.Clear()
+	if !q.Empty() || len(q.ToSlice()) != 0 {
+		t.Errorf("Got %v, expected an empty queue", q.ToSlice())
+	}
+}
+
+func TestNewDelay(t *testing.T) {
+	q := NewDelay(containers.IntContainer(0))
+	start := time.Now()
+	_ = q.Put(1, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	value, err := q.Take(ctx)
+	if err != nil || value != 1 {
+		t.Errorf("Got %v (%v), expected 1", value, err)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("Got the element after %v, expected it after 10ms", elapsed)
+	}
+}
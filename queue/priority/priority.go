@@ -0,0 +1,322 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package priority exposes a priority queue data-structure, https://en.wikipedia.org/wiki/Priority_queue.
+//
+// Every element of a priority queue is pushed along with a priority, and the element with the highest priority is
+// popped first. The queue is backed by a heaps.MinHeap of Handles: pushing an element returns a Handle, which being
+// a heaps.Indexed element tracks its position in the heap, hence the priority of an element can be updated, or the
+// element removed, in O(log(n)) time.
+package priority
+
+import (
+	"errors"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/trees/heaps"
+	"sync"
+)
+
+// ErrInvalidHandle is returned when a Handle doesn't refer to an element of the queue, i.e., the element has been
+// popped or removed, or the Handle was returned by another queue.
+var ErrInvalidHandle = errors.New("handle does not refer to an element of the queue")
+
+// Order is the order in which the elements of a queue are popped.
+type Order uint8
+
+const (
+	// Min pops the element with the lowest priority first.
+	Min Order = iota
+	// Max pops the element with the highest priority first.
+	Max
+)
+
+// Handle refers to an element pushed into a Queue, to update its priority or remove it from the queue.
+type Handle struct {
+	value    containers.Container
+	priority containers.Container
+	// index is the position of the element in the heap, -1 once the element is no longer in the queue
+	index int
+	// sequence orders the elements of equal priorities in the order they were pushed
+	sequence uint64
+	queue    *Queue
+}
+
+// Value returns the value of the element.
+func (h *Handle) Value() interface{} {
+	return containers.CleanBasicType(h.value)
+}
+
+// Priority returns the current priority of the element.
+func (h *Handle) Priority() interface{} {
+	h.queue.mu.RLock()
+	defer h.queue.mu.RUnlock()
+
+	return containers.CleanBasicType(h.priority)
+}
+
+// Key returns the Handle itself, which identifies the element; implements containers.Container.
+func (h *Handle) Key() interface{} {
+	return h
+}
+
+// Less reports whether the element is ordered before the other element by the Less method of the priorities,
+// elements of equal priorities being ordered in the order they were pushed; implements containers.Container.
+func (h *Handle) Less(other containers.Container) bool {
+	return byPriority(containers.Natural)(h, other)
+}
+
+// Validate converts x to a *Handle, panicking if it isn't one; implements containers.Container.
+func (h *Handle) Validate(x interface{}) containers.Container {
+	return x.(*Handle)
+}
+
+// SetIndex records the position of the element in the heap; implements heaps.Indexed.
+func (h *Handle) SetIndex(index int) {
+	h.index = index
+}
+
+// byPriority orders the handles by their priorities using the comparator, and the handles of equal priorities in
+// the order they were pushed
+func byPriority(comparator containers.Comparator) containers.Comparator {
+	return func(a, b containers.Container) bool {
+		x, y := a.(*Handle), b.(*Handle)
+		if comparator(x.priority, y.priority) {
+			return true
+		}
+		if comparator(y.priority, x.priority) {
+			return false
+		}
+		return x.sequence < y.sequence
+	}
+}
+
+// Queue is a priority queue, popping its elements from the highest priority to the lowest, where the highest
+// priority is the lowest or highest priority depending on the queue's Order, or the priority ordered first by the
+// queue's comparator. Elements of equal priorities are popped in the order they were pushed.
+//
+// All the operations on the queue are thread-safe.
+type Queue struct {
+	heap         *heaps.MinHeap
+	valueType    containers.Container
+	priorityType containers.Container
+	sequence     uint64
+	mu           sync.RWMutex
+}
+
+/** Element Access **/
+
+// Peek returns the value of the element with the highest priority without removing it, or nil if the queue is empty.
+func (q *Queue) Peek() interface{} {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.heap.Empty() {
+		return nil
+	}
+	return containers.CleanBasicType(q.heap.Peek().(*Handle).value)
+}
+
+// PeekPriority returns the priority of the element with the highest priority, or nil if the queue is empty.
+func (q *Queue) PeekPriority() interface{} {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.heap.Empty() {
+		return nil
+	}
+	return containers.CleanBasicType(q.heap.Peek().(*Handle).priority)
+}
+
+/** Modifiers **/
+
+// Push adds the value with the priority provided to the queue, and returns the Handle of the new element.
+// Time complexity is O(log(n)). Panics if an invalid type is provided.
+func (q *Queue) Push(value interface{}, priority interface{}) *Handle {
+	return q.push(q.valueType.Validate(value), q.priorityType.Validate(priority))
+}
+
+// TryPush is similar to Push, but returns a *containers.TypeError instead of panicking if the value or the priority
+// is of an invalid type.
+func (q *Queue) TryPush(value interface{}, priority interface{}) (*Handle, error) {
+	element, err := containers.TryValidate(q.valueType, value)
+	if err != nil {
+		return nil, err
+	}
+	p, err := containers.TryValidate(q.priorityType, priority)
+	if err != nil {
+		return nil, err
+	}
+	return q.push(element, p), nil
+}
+
+func (q *Queue) push(value, priority containers.Container) *Handle {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	handle := &Handle{value: value, priority: priority, sequence: q.sequence, queue: q}
+	q.sequence++
+	q.heap.Insert(handle)
+	return handle
+}
+
+// Pop removes the element with the highest priority from the queue and returns its value, or nil if the queue is
+// empty. Time complexity is O(log(n)).
+func (q *Queue) Pop() interface{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.heap.Empty() {
+		return nil
+	}
+	return containers.CleanBasicType(q.heap.Extract().(*Handle).value)
+}
+
+// UpdatePriority changes the priority of the element referred to by the Handle, moving it to its new position in
+// the queue. Time complexity is O(log(n)). Returns ErrInvalidHandle if the element is no longer in the queue, or a
+// *containers.TypeError if the priority is of an invalid type.
+func (q *Queue) UpdatePriority(handle *Handle, priority interface{}) error {
+	p, err := containers.TryValidate(q.priorityType, priority)
+	if err != nil {
+		return err
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.checkHandle(handle); err != nil {
+		return err
+	}
+	handle.priority = p
+	q.heap.Fix(handle.index)
+	return nil
+}
+
+// Remove removes the element referred to by the Handle from the queue. Time complexity is O(log(n)).
+// Returns ErrInvalidHandle if the element is no longer in the queue.
+func (q *Queue) Remove(handle *Handle) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.checkHandle(handle); err != nil {
+		return err
+	}
+	q.heap.Remove(handle.index)
+	return nil
+}
+
+// Contains reports whether the element referred to by the Handle is in the queue.
+func (q *Queue) Contains(handle *Handle) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return q.checkHandle(handle) == nil
+}
+
+// Clear removes all the elements from the queue, invalidating their handles.
+func (q *Queue) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.heap.Clear()
+}
+
+// checkHandle returns ErrInvalidHandle if the Handle doesn't refer to an element of the queue. The caller must hold
+// the queue's lock.
+func (q *Queue) checkHandle(handle *Handle) error {
+	if handle == nil || handle.queue != q || handle.index < 0 {
+		return ErrInvalidHandle
+	}
+	return nil
+}
+
+/** Capacity **/
+
+// Len returns the number of elements in the queue.
+func (q *Queue) Len() int64 {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return q.heap.Size()
+}
+
+// Size returns the number of elements in the queue, same as Len.
+func (q *Queue) Size() int64 {
+	return q.Len()
+}
+
+// Empty reports whether the queue has no elements.
+func (q *Queue) Empty() bool {
+	return q.Len() == 0
+}
+
+// ToSlice returns the values of the queue's elements in the heap's internal order, i.e., the element with the
+// highest priority is the first element, but the remaining elements are not sorted.
+func (q *Queue) ToSlice() []interface{} {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	values := q.heap.ToSlice()
+	for i, handle := range values {
+		values[i] = containers.CleanBasicType(handle.(*Handle).value)
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the queue in the heap's internal order.
+func (q *Queue) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(q.ToSlice())
+}
+
+/** Constructors **/
+
+// New instantiates an empty priority queue of values of the valueType's type, prioritised by priorities of the
+// priorityType's type in the order provided.
+func New(valueType, priorityType containers.Container, order Order) *Queue {
+	comparator := containers.Natural
+	if order == Max {
+		comparator = comparator.Reverse()
+	}
+	return NewFunc(valueType, priorityType, comparator)
+}
+
+// NewFunc instantiates an empty priority queue whose priorities are ordered by the comparator provided instead of
+// the Less method of the priorities, i.e., the element whose priority is ordered first by the comparator is popped
+// first.
+func NewFunc(valueType, priorityType containers.Container, comparator containers.Comparator) *Queue {
+	return &Queue{
+		heap:         heaps.NewMinFunc(&Handle{}, byPriority(comparator)),
+		valueType:    valueType,
+		priorityType: priorityType,
+	}
+}
+
+// NewMin instantiates an empty priority queue with integer priorities, popping the lowest priority first.
+func NewMin(valueType containers.Container) *Queue {
+	return New(valueType, containers.IntContainer(0), Min)
+}
+
+// NewMax instantiates an empty priority queue with integer priorities, popping the highest priority first.
+func NewMax(valueType containers.Container) *Queue {
+	return New(valueType, containers.IntContainer(0), Max)
+}
@@ -0,0 +1,213 @@
+package priority
+
+import (
+	"errors"
+	"github.com/soheltarir/gollections/containers"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func popAll(q *Queue) []interface{} {
+	var values []interface{}
+	for !q.Empty() {
+		values = append(values, q.Pop())
+	}
+	return values
+}
+
+func TestQueue_Min(t *testing.T) {
+	q := NewMin(containers.StringContainer(""))
+	if q.Pop() != nil || q.Peek() != nil || q.PeekPriority() != nil {
+		t.Errorf("Got a value, expected nil")
+	}
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("b", 2)
+	if q.Len() != 3 {
+		t.Errorf("Got %d, expected 3", q.Len())
+	}
+	if q.Peek() != "a" || q.PeekPriority() != 1 {
+		t.Errorf("Got %v with priority %v, expected a with priority 1", q.Peek(), q.PeekPriority())
+	}
+	if values := popAll(q); !reflect.DeepEqual(values, []interface{}{"a", "b", "c"}) {
+		t.Errorf("Got %v, expected [a b c]", values)
+	}
+}
+
+func TestQueue_Max(t *testing.T) {
+	q := NewMax(containers.StringContainer(""))
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("b", 2)
+	if values := popAll(q); !reflect.DeepEqual(values, []interface{}{"c", "b", "a"}) {
+		t.Errorf("Got %v, expected [c b a]", values)
+	}
+}
+
+func TestQueue_EqualPriorities(t *testing.T) {
+	q := NewMax(containers.IntContainer(0))
+	for i := 0; i < 5; i++ {
+		q.Push(i, 1)
+	}
+	q.Push(5, 2)
+	if values := popAll(q); !reflect.DeepEqual(values, []interface{}{5, 0, 1, 2, 3, 4}) {
+		t.Errorf("Got %v, expected the elements of equal priorities in the order they were pushed", values)
+	}
+}
+
+func TestNewFunc(t *testing.T) {
+	// Order the string priorities by their length
+	byLength := containers.ByKey(func(c containers.Container) interface{} { return len(containers.ToString(c)) })
+	q := NewFunc(containers.IntContainer(0), containers.StringContainer(""), byLength)
+	q.Push(3, "ccc")
+	q.Push(1, "a")
+	q.Push(2, "bb")
+	if values := popAll(q); !reflect.DeepEqual(values, []interface{}{1, 2, 3}) {
+		t.Errorf("Got %v, expected [1 2 3]", values)
+	}
+}
+
+func TestQueue_TryPush(t *testing.T) {
+	q := NewMin(containers.IntContainer(0))
+	var typeError *containers.TypeError
+	if _, err := q.TryPush("a", 1); !errors.As(err, &typeError) {
+		t.Errorf("Got %v, expected a type error", err)
+	}
+	if _, err := q.TryPush(1, "a"); !errors.As(err, &typeError) {
+		t.Errorf("Got %v, expected a type error", err)
+	}
+	if !q.Empty() {
+		t.Errorf("Got %v, expected an empty queue", q.ToSlice())
+	}
+	handle, err := q.TryPush(1, 2)
+	if err != nil || handle.Value() != 1 || handle.Priority() != 2 {
+		t.Errorf("Got %v, expected a handle of 1 with priority 2", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Push didn't panic on an invalid type")
+		}
+	}()
+	q.Push(1, "a")
+}
+
+func TestQueue_UpdatePriority(t *testing.T) {
+	q := NewMin(containers.StringContainer(""))
+	a := q.Push("a", 1)
+	b := q.Push("b", 2)
+	c := q.Push("c", 3)
+
+	if err := q.UpdatePriority(c, 0); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if q.Peek() != "c" || c.Priority() != 0 {
+		t.Errorf("Got %v, expected c", q.Peek())
+	}
+	if err := q.UpdatePriority(c, 5); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if err := q.UpdatePriority(a, 4); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	var typeError *containers.TypeError
+	if err := q.UpdatePriority(b, "a"); !errors.As(err, &typeError) {
+		t.Errorf("Got %v, expected a type error", err)
+	}
+	if values := popAll(q); !reflect.DeepEqual(values, []interface{}{"b", "a", "c"}) {
+		t.Errorf("Got %v, expected [b a c]", values)
+	}
+	if err := q.UpdatePriority(a, 1); !errors.Is(err, ErrInvalidHandle) {
+		t.Errorf("Got %v, expected %v", err, ErrInvalidHandle)
+	}
+}
+
+func TestQueue_Remove(t *testing.T) {
+	q := NewMin(containers.IntContainer(0))
+	handles := make([]*Handle, 5)
+	for i := range handles {
+		handles[i] = q.Push(i, i)
+	}
+	if err := q.Remove(handles[0]); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if err := q.Remove(handles[3]); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if q.Contains(handles[3]) || !q.Contains(handles[4]) {
+		t.Errorf("Got unexpected result of Contains")
+	}
+	if err := q.Remove(handles[3]); !errors.Is(err, ErrInvalidHandle) {
+		t.Errorf("Got %v, expected %v", err, ErrInvalidHandle)
+	}
+	other := NewMin(containers.IntContainer(0))
+	if err := other.Remove(handles[1]); !errors.Is(err, ErrInvalidHandle) {
+		t.Errorf("Got %v, expected %v", err, ErrInvalidHandle)
+	}
+	if err := q.Remove(nil); !errors.Is(err, ErrInvalidHandle) {
+		t.Errorf("Got %v, expected %v", err, ErrInvalidHandle)
+	}
+	if values := popAll(q); !reflect.DeepEqual(values, []interface{}{1, 2, 4}) {
+		t.Errorf("Got %v, expected [1 2 4]", values)
+	}
+}
+
+func TestQueue_Clear(t *testing.T) {
+	q := NewMin(containers.IntContainer(0))
+	handle := q.Push(1, 1)
+	q.Push(2, 2)
+	q.Clear()
+	if !q.Empty() || q.Contains(handle) {
+		t.Errorf("Got %v, expected an empty queue", q.ToSlice())
+	}
+	if err := q.UpdatePriority(handle, 0); !errors.Is(err, ErrInvalidHandle) {
+		t.Errorf("Got %v, expected %v", err, ErrInvalidHandle)
+	}
+}
+
+func TestQueue_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	q := NewMin(containers.IntContainer(0))
+	priorities := make(map[*Handle]int)
+	for i := 0; i < 2000; i++ {
+		switch op := random.Intn(4); {
+		case op < 2 || len(priorities) == 0:
+			priority := random.Intn(100)
+			priorities[q.Push(i, priority)] = priority
+		case op == 2:
+			for handle := range priorities {
+				priority := random.Intn(100)
+				if err := q.UpdatePriority(handle, priority); err != nil {
+					t.Fatalf("Got error %v", err)
+				}
+				priorities[handle] = priority
+				break
+			}
+		default:
+			for handle := range priorities {
+				if err := q.Remove(handle); err != nil {
+					t.Fatalf("Got error %v", err)
+				}
+				delete(priorities, handle)
+				break
+			}
+		}
+	}
+
+	expected := make([]int, 0, len(priorities))
+	for _, priority := range priorities {
+		expected = append(expected, priority)
+	}
+	sort.Ints(expected)
+	for _, priority := range expected {
+		if q.PeekPriority() != priority {
+			t.Fatalf("Got %v, expected %d", q.PeekPriority(), priority)
+		}
+		q.Pop()
+	}
+	if !q.Empty() {
+		t.Errorf("Got %d elements, expected an empty queue", q.Len())
+	}
+}
@@ -31,14 +31,40 @@ SOFTWARE.
 package queue
 
 import (
+	"github.com/soheltarir/gollections"
 	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/deque"
 	"github.com/soheltarir/gollections/lists"
+	"io"
+	"iter"
 )
 
+// storage is the sequence container the elements of a queue are stored in, either a lists.LinkedList or a
+// deque.Deque
+type storage interface {
+	Front() interface{}
+	Back() interface{}
+	All() iter.Seq2[int64, interface{}]
+	PushBack(value interface{})
+	TryPushBack(value interface{}) error
+	PopFront() interface{}
+	Clear()
+	Size() int64
+	Empty() bool
+	ToSlice() []interface{}
+	Iter() gollections.Iterator
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(data []byte) error
+	Snapshot(w io.Writer) error
+	Restore(r io.Reader) error
+	GobEncode() ([]byte, error)
+	GobDecode(data []byte) error
+}
+
 // A Queue is a linear structure which follows a particular order in which the operations are performed.
 // The order is First In First Out (FIFO)
 type Queue struct {
-	data *lists.LinkedList
+	data storage
 }
 
 // Enqueue Inserts a new element at the end of the queue, after its current last element.
@@ -46,6 +72,12 @@ func (q *Queue) Enqueue(value interface{}) {
 	q.data.PushBack(value)
 }
 
+// TryEnqueue is similar to Enqueue, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (q *Queue) TryEnqueue(value interface{}) error {
+	return q.data.TryPushBack(value)
+}
+
 // Dequeue Removes the next element in the queue, effectively reducing its size by one.
 // The element removed is the "oldest" element in the queue whose value can be retrieved by calling method Front().
 func (q *Queue) Dequeue() interface{} {
@@ -78,6 +110,71 @@ func (q *Queue) Clear() {
 	q.data.Clear()
 }
 
+// ToSlice returns the elements of the queue from the front to the back.
+func (q *Queue) ToSlice() []interface{} {
+	return q.data.ToSlice()
+}
+
+// Values returns an iterator over the elements of the queue from the front to the back.
+// The queue must not be modified within the loop.
+func (q *Queue) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, value := range q.data.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Iter returns a gollections.Iterator traversing the queue from the front to the back.
+func (q *Queue) Iter() gollections.Iterator {
+	return q.data.Iter()
+}
+
+// MarshalJSON implements json.Marshaler; the queue is encoded as a JSON array of its elements from the front to the
+// back.
+func (q *Queue) MarshalJSON() ([]byte, error) {
+	return q.data.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler; decodes a JSON array (front to back) into the elements of the queue,
+// replacing its current contents. The queue must be created using New (or one of the NewX constructors) beforehand.
+func (q *Queue) UnmarshalJSON(data []byte) error {
+	if q.data == nil {
+		return containers.ErrNoValueType
+	}
+	return q.data.UnmarshalJSON(data)
+}
+
+// Snapshot writes the elements of the queue from the front to the back in the binary format of the snapshot package.
+func (q *Queue) Snapshot(w io.Writer) error {
+	return q.data.Snapshot(w)
+}
+
+// Restore reads a snapshot written by Snapshot, replacing the current contents of the queue. The queue must be
+// created using New (or one of the NewX constructors) beforehand, and is left unmodified in case of an error.
+func (q *Queue) Restore(r io.Reader) error {
+	if q.data == nil {
+		return containers.ErrNoValueType
+	}
+	return q.data.Restore(r)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format written by Snapshot.
+func (q *Queue) GobEncode() ([]byte, error) {
+	return q.data.GobEncode()
+}
+
+// GobDecode implements gob.GobDecoder; the queue must be created using New (or one of the NewX constructors)
+// beforehand.
+func (q *Queue) GobDecode(data []byte) error {
+	if q.data == nil {
+		return containers.ErrNoValueType
+	}
+	return q.data.GobDecode(data)
+}
+
 // New instantiates a new queue with the items provided (order is preserved)
 func New(valueType containers.Container, values ...interface{}) *Queue {
 	// Initialise a linked list
@@ -86,6 +183,13 @@ func New(valueType containers.Container, values ...interface{}) *Queue {
 	return &Queue{data: list}
 }
 
+// NewWithDeque instantiates a new queue with the items provided (order is preserved), storing its elements in a
+// deque.Deque instead of a linked list. The elements are stored contiguously in a ring buffer, avoiding the
+// allocation of a node per element.
+func NewWithDeque(valueType containers.Container, values ...interface{}) *Queue {
+	return &Queue{data: deque.New(valueType, values...)}
+}
+
 // NewInt instantiates a new queue which can contain integer elements
 func NewInt(values ...interface{}) *Queue {
 	return New(containers.IntContainer(0), values...)
@@ -95,3 +199,83 @@ func NewInt(values ...interface{}) *Queue {
 func NewString(values ...interface{}) *Queue {
 	return New(containers.StringContainer(""), values...)
 }
+
+// NewFloat64 instantiates a new queue which can contain float64 elements
+func NewFloat64(values ...interface{}) *Queue {
+	return New(containers.Float64Container(0), values...)
+}
+
+// NewInt64 instantiates a new queue which can contain int64 elements
+func NewInt64(values ...interface{}) *Queue {
+	return New(containers.Int64Container(0), values...)
+}
+
+// NewUint64 instantiates a new queue which can contain uint64 elements
+func NewUint64(values ...interface{}) *Queue {
+	return New(containers.Uint64Container(0), values...)
+}
+
+// NewInt8 instantiates a new queue which can contain int8 elements
+func NewInt8(values ...interface{}) *Queue {
+	return New(containers.Int8Container(0), values...)
+}
+
+// NewInt16 instantiates a new queue which can contain int16 elements
+func NewInt16(values ...interface{}) *Queue {
+	return New(containers.Int16Container(0), values...)
+}
+
+// NewInt32 instantiates a new queue which can contain int32 elements
+func NewInt32(values ...interface{}) *Queue {
+	return New(containers.Int32Container(0), values...)
+}
+
+// NewUint instantiates a new queue which can contain uint elements
+func NewUint(values ...interface{}) *Queue {
+	return New(containers.UintContainer(0), values...)
+}
+
+// NewUint8 instantiates a new queue which can contain uint8 elements
+func NewUint8(values ...interface{}) *Queue {
+	return New(containers.Uint8Container(0), values...)
+}
+
+// NewUint16 instantiates a new queue which can contain uint16 elements
+func NewUint16(values ...interface{}) *Queue {
+	return New(containers.Uint16Container(0), values...)
+}
+
+// NewUint32 instantiates a new queue which can contain uint32 elements
+func NewUint32(values ...interface{}) *Queue {
+	return New(containers.Uint32Container(0), values...)
+}
+
+// NewFloat32 instantiates a new queue which can contain float32 elements
+func NewFloat32(values ...interface{}) *Queue {
+	return New(containers.Float32Container(0), values...)
+}
+
+// NewRune instantiates a new queue which can contain rune elements
+func NewRune(values ...interface{}) *Queue {
+	return New(containers.RuneContainer(0), values...)
+}
+
+// NewBool instantiates a new queue which can contain bool elements
+func NewBool(values ...interface{}) *Queue {
+	return New(containers.BoolContainer(false), values...)
+}
+
+// NewTime instantiates a new queue which can contain time.Time elements
+func NewTime(values ...interface{}) *Queue {
+	return New(containers.TimeContainer{}, values...)
+}
+
+// NewDuration instantiates a new queue which can contain time.Duration elements
+func NewDuration(values ...interface{}) *Queue {
+	return New(containers.DurationContainer(0), values...)
+}
+
+// NewBytes instantiates a new queue which can contain []byte elements
+func NewBytes(values ...interface{}) *Queue {
+	return New(containers.BytesContainer{}, values...)
+}
@@ -1,6 +1,14 @@
 package queue
 
-import "testing"
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"github.com/soheltarir/gollections/containers"
+	"testing"
+	"time"
+)
 
 func TestNew(t *testing.T) {
 	q := NewInt(1, 2, 3)
@@ -42,3 +50,153 @@ func TestQueue_Empty(t *testing.T) {
 		t.Errorf("Queue is should not be empty")
 	}
 }
+
+func TestQueue_TryEnqueue(t *testing.T) {
+	q := NewString()
+	if err := q.TryEnqueue("a"); err != nil {
+		t.Errorf("Got error %v, expected nil", err)
+	}
+	if err := q.TryEnqueue(1); err == nil {
+		t.Errorf("Got nil, expected an error")
+	}
+	if q.Size() != 1 {
+		t.Errorf("Got %d, expected 1", q.Size())
+	}
+}
+
+func TestNewDuration(t *testing.T) {
+	q := NewDuration(time.Second, time.Minute)
+	if q.Dequeue() != time.Second {
+		t.Errorf("Got unexpected value")
+	}
+}
+
+func TestQueue_ToSlice(t *testing.T) {
+	q := NewInt(1, 2, 3)
+	if len(q.ToSlice()) != 3 || q.ToSlice()[0] != 1 {
+		t.Errorf("Got %v, expected [1 2 3]", q.ToSlice())
+	}
+	if it := q.Iter(); it.Next() != 1 {
+		t.Errorf("Got unexpected value")
+	}
+}
+
+func TestQueue_Values(t *testing.T) {
+	q := NewInt(1, 2, 3)
+	var actual []interface{}
+	for v := range q.Values() {
+		actual = append(actual, v)
+		if v == 2 {
+			break
+		}
+	}
+	if len(actual) != 2 || actual[0] != 1 {
+		t.Errorf("Got %v, expected [1 2]", actual)
+	}
+	q.Enqueue(4)
+	if q.Size() != 4 {
+		t.Errorf("Got %d, expected 4", q.Size())
+	}
+}
+
+func TestQueue_JSON(t *testing.T) {
+	q := NewString("a", "b", "c")
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if string(data) != `["a","b","c"]` {
+		t.Errorf("Got %s, expected [\"a\",\"b\",\"c\"]", data)
+	}
+
+	decoded := NewString()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if decoded.Size() != 3 || decoded.Front() != "a" || decoded.Back() != "c" {
+		t.Errorf("Got %v, expected [a b c]", decoded.ToSlice())
+	}
+	if err := json.Unmarshal([]byte(`[1]`), decoded); err == nil {
+		t.Errorf("Expected an error decoding integers into a string queue")
+	}
+	var zero Queue
+	if err := json.Unmarshal(data, &zero); !errors.Is(err, containers.ErrNoValueType) {
+		t.Errorf("Got %v, expected %v", err, containers.ErrNoValueType)
+	}
+}
+
+func TestQueue_Snapshot(t *testing.T) {
+	q := NewInt(1, 2, 3)
+	var buf bytes.Buffer
+	if err := q.Snapshot(&buf); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	restored := NewInt()
+	if err := restored.Restore(&buf); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if restored.Size() != 3 || restored.Dequeue() != 1 || restored.Back() != 3 {
+		t.Errorf("Got %v, expected [1 2 3]", restored.ToSlice())
+	}
+
+	var zero Queue
+	if err := zero.Restore(&buf); !errors.Is(err, containers.ErrNoValueType) {
+		t.Errorf("Got %v, expected %v", err, containers.ErrNoValueType)
+	}
+}
+
+func TestQueue_Gob(t *testing.T) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(NewString("a", "b")); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	decoded := NewString()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if decoded.Front() != "a" || decoded.Back() != "b" {
+		t.Errorf("Got %v, expected [a b]", decoded.ToSlice())
+	}
+}
+
+func TestNewWithDeque(t *testing.T) {
+	q := NewWithDeque(containers.IntContainer(0), 1, 2)
+	q.Enqueue(3)
+	if q.Front() != 1 || q.Back() != 3 || q.Size() != 3 {
+		t.Errorf("Got %v, expected [1 2 3]", q.ToSlice())
+	}
+	if q.Dequeue() != 1 || q.Dequeue() != 2 {
+		t.Errorf("Got unexpected value")
+	}
+	if err := q.TryEnqueue("a"); err == nil {
+		t.Errorf("Got nil, expected a type error")
+	}
+
+	// Snapshots are interchangeable between the storages
+	var buf bytes.Buffer
+	if err := q.Snapshot(&buf); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	restored := NewInt()
+	if err := restored.Restore(&buf); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if restored.Size() != 1 || restored.Front() != 3 {
+		t.Errorf("Got %v, expected [3]", restored.ToSlice())
+	}
+
+	data, err := json.Marshal(restored)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if err := json.Unmarshal(data, q); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if q.Size() != 1 || q.Front() != 3 {
+		t.Errorf("Got %v, expected [3]", q.ToSlice())
+	}
+	q.Clear()
+	if !q.Empty() || q.Dequeue() != nil {
+		t.Errorf("Got %v, expected an empty queue", q.ToSlice())
+	}
+}
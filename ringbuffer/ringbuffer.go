@@ -0,0 +1,370 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package ringbuffer exposes a fixed-capacity circular buffer, https://en.wikipedia.org/wiki/Circular_buffer.
+//
+// A ring buffer keeps up to its capacity of the most recent elements pushed into it, and is consumed from the oldest
+// element to the newest. The Policy of the buffer decides what happens when an element is pushed into a full buffer:
+// the oldest element is overwritten, the new element is rejected, or the push waits for an element to be popped.
+package ringbuffer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/soheltarir/gollections"
+	"github.com/soheltarir/gollections/containers"
+	"iter"
+	"sync"
+)
+
+// ErrFull is returned by Push when the buffer is full and its policy is Reject.
+var ErrFull = errors.New("ring buffer is full")
+
+// Policy is the behaviour of a ring buffer when an element is pushed while it is full.
+type Policy uint8
+
+const (
+	// Overwrite discards the oldest element of the buffer to make room for the new element.
+	Overwrite Policy = iota
+	// Reject discards the new element, Push returning ErrFull.
+	Reject
+	// Block waits for an element to be popped before pushing the new element.
+	Block
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Overwrite:
+		return "Overwrite"
+	case Reject:
+		return "Reject"
+	case Block:
+		return "Block"
+	default:
+		return fmt.Sprintf("Policy(%d)", uint8(p))
+	}
+}
+
+// RingBuffer is a circular buffer holding up to a fixed number of elements, from the oldest to the newest.
+//
+// All the operations on the buffer are thread-safe.
+type RingBuffer struct {
+	buffer    []containers.Container
+	head      int
+	size      int
+	policy    Policy
+	valueType containers.Container
+	mu        sync.RWMutex
+	// changed is closed (and replaced) to wake the pushes waiting for space whenever elements are removed
+	changed chan struct{}
+	waiting int
+}
+
+/** Element Access **/
+
+// Peek returns the value of the oldest element of the buffer without removing it, or nil if the buffer is empty.
+func (rb *RingBuffer) Peek() interface{} {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	if rb.size == 0 {
+		return nil
+	}
+	return containers.CleanBasicType(rb.buffer[rb.head])
+}
+
+// All returns an iterator over the index-value pairs of the buffer from the oldest to the newest element.
+// The buffer must not be modified within the loop.
+func (rb *RingBuffer) All() iter.Seq2[int64, interface{}] {
+	return func(yield func(int64, interface{}) bool) {
+		rb.mu.RLock()
+		defer rb.mu.RUnlock()
+
+		for i := 0; i < rb.size; i++ {
+			if !yield(int64(i), containers.CleanBasicType(rb.buffer[rb.index(i)])) {
+				return
+			}
+		}
+	}
+}
+
+/** Modifiers **/
+
+// Push adds the value as the newest element of the buffer. If the buffer is full, the oldest element is overwritten
+// when the policy is Overwrite, ErrFull is returned when the policy is Reject, and Push waits for an element to be
+// popped when the policy is Block. Returns a *containers.TypeError if an invalid type is provided.
+func (rb *RingBuffer) Push(value interface{}) error {
+	return rb.PushContext(context.Background(), value)
+}
+
+// PushContext is similar to Push, but returns the context's error if the context is done before a buffer with the
+// Block policy had space for the value.
+func (rb *RingBuffer) PushContext(ctx context.Context, value interface{}) error {
+	element, err := containers.TryValidate(rb.valueType, value)
+	if err != nil {
+		return err
+	}
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	for rb.size == len(rb.buffer) {
+		switch rb.policy {
+		case Overwrite:
+			rb.buffer[rb.head] = element
+			rb.head = rb.index(1)
+			return nil
+		case Reject:
+			return ErrFull
+		}
+		if err := rb.wait(ctx); err != nil {
+			return err
+		}
+	}
+	rb.buffer[rb.index(rb.size)] = element
+	rb.size++
+	return nil
+}
+
+// Pop removes the oldest element of the buffer and returns its value, or nil if the buffer is empty.
+func (rb *RingBuffer) Pop() interface{} {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	if rb.size == 0 {
+		return nil
+	}
+	element := rb.buffer[rb.head]
+	rb.buffer[rb.head] = nil
+	rb.head = rb.index(1)
+	rb.size--
+	rb.signal()
+	return containers.CleanBasicType(element)
+}
+
+// Clear deletes all the elements of the buffer, waking the pushes waiting for space to become available.
+func (rb *RingBuffer) Clear() {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	clear(rb.buffer)
+	rb.head = 0
+	rb.size = 0
+	rb.signal()
+}
+
+/** Capacity **/
+
+// Len returns the number of elements in the buffer.
+func (rb *RingBuffer) Len() int64 {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	return int64(rb.size)
+}
+
+// Cap returns the maximum number of elements in the buffer.
+func (rb *RingBuffer) Cap() int64 {
+	return int64(len(rb.buffer))
+}
+
+// Size returns the number of elements in the buffer, same as Len.
+func (rb *RingBuffer) Size() int64 {
+	return rb.Len()
+}
+
+// Empty returns whether the buffer is empty, i.e. whether its size is zero.
+func (rb *RingBuffer) Empty() bool {
+	return rb.Len() == 0
+}
+
+// Full returns whether the buffer holds as many elements as its capacity.
+func (rb *RingBuffer) Full() bool {
+	return rb.Len() == rb.Cap()
+}
+
+// Policy returns the behaviour of the buffer when an element is pushed while it is full.
+func (rb *RingBuffer) Policy() Policy {
+	return rb.policy
+}
+
+// ToSlice returns the values of the buffer's elements from the oldest to the newest.
+func (rb *RingBuffer) ToSlice() []interface{} {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	values := make([]interface{}, rb.size)
+	for i := range values {
+		values[i] = containers.CleanBasicType(rb.buffer[rb.index(i)])
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the buffer from the oldest to the newest element.
+func (rb *RingBuffer) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(rb.ToSlice())
+}
+
+/** Internal Functions **/
+
+// index returns the position in the buffer of the i-th oldest element
+func (rb *RingBuffer) index(i int) int {
+	return (rb.head + i) % len(rb.buffer)
+}
+
+// wait waits until elements are removed from the buffer or the context is done. The caller must hold the buffer's
+// lock, which is released while waiting.
+func (rb *RingBuffer) wait(ctx context.Context) error {
+	changed := rb.changed
+	rb.waiting++
+	rb.mu.Unlock()
+
+	var err error
+	select {
+	case <-changed:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	rb.mu.Lock()
+	rb.waiting--
+	return err
+}
+
+// signal wakes the waiting pushes to re-check the buffer. The caller must hold the buffer's lock.
+func (rb *RingBuffer) signal() {
+	if rb.waiting > 0 {
+		close(rb.changed)
+		rb.changed = make(chan struct{})
+	}
+}
+
+/** Constructors **/
+
+// New instantiates an empty ring buffer of the Container type provided, holding up to capacity elements and
+// behaving according to the policy provided when full. Panics if the capacity is not positive, or if the policy is
+// not one of Overwrite, Reject & Block.
+func New(valueType containers.Container, capacity int64, policy Policy) *RingBuffer {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("ring buffer capacity must be positive, received: %d", capacity))
+	}
+	if policy > Block {
+		panic(fmt.Sprintf("ring buffer policy must be Overwrite, Reject or Block, received: %s", policy))
+	}
+	return &RingBuffer{
+		buffer:    make([]containers.Container, capacity),
+		policy:    policy,
+		valueType: valueType,
+		changed:   make(chan struct{}),
+	}
+}
+
+// NewInt instantiates a ring buffer which can contain integer elements
+func NewInt(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.IntContainer(0), capacity, policy)
+}
+
+// NewString instantiates a ring buffer which can contain string elements
+func NewString(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.StringContainer(""), capacity, policy)
+}
+
+// NewFloat64 instantiates a ring buffer which can contain float64 elements
+func NewFloat64(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Float64Container(0), capacity, policy)
+}
+
+// NewInt64 instantiates a ring buffer which can contain int64 elements
+func NewInt64(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Int64Container(0), capacity, policy)
+}
+
+// NewUint64 instantiates a ring buffer which can contain uint64 elements
+func NewUint64(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Uint64Container(0), capacity, policy)
+}
+
+// NewInt8 instantiates a ring buffer which can contain int8 elements
+func NewInt8(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Int8Container(0), capacity, policy)
+}
+
+// NewInt16 instantiates a ring buffer which can contain int16 elements
+func NewInt16(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Int16Container(0), capacity, policy)
+}
+
+// NewInt32 instantiates a ring buffer which can contain int32 elements
+func NewInt32(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Int32Container(0), capacity, policy)
+}
+
+// NewUint instantiates a ring buffer which can contain uint elements
+func NewUint(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.UintContainer(0), capacity, policy)
+}
+
+// NewUint8 instantiates a ring buffer which can contain uint8 elements
+func NewUint8(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Uint8Container(0), capacity, policy)
+}
+
+// NewUint16 instantiates a ring buffer which can contain uint16 elements
+func NewUint16(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Uint16Container(0), capacity, policy)
+}
+
+// NewUint32 instantiates a ring buffer which can contain uint32 elements
+func NewUint32(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Uint32Container(0), capacity, policy)
+}
+
+// NewFloat32 instantiates a ring buffer which can contain float32 elements
+func NewFloat32(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.Float32Container(0), capacity, policy)
+}
+
+// NewRune instantiates a ring buffer which can contain rune elements
+func NewRune(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.RuneContainer(0), capacity, policy)
+}
+
+// NewBool instantiates a ring buffer which can contain bool elements
+func NewBool(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.BoolContainer(false), capacity, policy)
+}
+
+// NewTime instantiates a ring buffer which can contain time.Time elements
+func NewTime(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.TimeContainer{}, capacity, policy)
+}
+
+// NewDuration instantiates a ring buffer which can contain time.Duration elements
+func NewDuration(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.DurationContainer(0), capacity, policy)
+}
+
+// NewBytes instantiates a ring buffer which can contain []byte elements
+func NewBytes(capacity int64, policy Policy) *RingBuffer {
+	return New(containers.BytesContainer{}, capacity, policy)
+}
@@ -0,0 +1,151 @@
+package ringbuffer
+
+import (
+	"context"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	rb := NewInt(3, Overwrite)
+	assert.Equal(t, int64(3), rb.Cap())
+	assert.Equal(t, int64(0), rb.Len())
+	assert.True(t, rb.Empty())
+	assert.Equal(t, Overwrite, rb.Policy())
+	assert.Panics(t, func() { NewInt(0, Overwrite) })
+	assert.Panics(t, func() { NewInt(-1, Reject) })
+	assert.PanicsWithValue(t, "ring buffer policy must be Overwrite, Reject or Block, received: Policy(7)", func() {
+		NewInt(1, Policy(7))
+	})
+}
+
+func TestRingBuffer_PushPop(t *testing.T) {
+	rb := NewString(3, Reject)
+	assert.Nil(t, rb.Pop())
+	assert.Nil(t, rb.Peek())
+
+	assert.NoError(t, rb.Push("a"))
+	assert.NoError(t, rb.Push("b"))
+	assert.Equal(t, "a", rb.Peek())
+	assert.Equal(t, "a", rb.Pop())
+	assert.NoError(t, rb.Push("c"))
+	assert.NoError(t, rb.Push("d"))
+	assert.True(t, rb.Full())
+	// The elements wrap around the end of the buffer
+	assert.Equal(t, []interface{}{"b", "c", "d"}, rb.ToSlice())
+	assert.Equal(t, "b", rb.Pop())
+	assert.Equal(t, "c", rb.Pop())
+	assert.Equal(t, "d", rb.Pop())
+	assert.True(t, rb.Empty())
+}
+
+func TestRingBuffer_Push_TypeError(t *testing.T) {
+	rb := NewInt(2, Overwrite)
+	var typeError *containers.TypeError
+	assert.ErrorAs(t, rb.Push("a"), &typeError)
+	assert.True(t, rb.Empty())
+}
+
+func TestRingBuffer_Overwrite(t *testing.T) {
+	rb := NewInt(3, Overwrite)
+	for i := 1; i <= 5; i++ {
+		assert.NoError(t, rb.Push(i))
+	}
+	assert.Equal(t, int64(3), rb.Len())
+	assert.Equal(t, []interface{}{3, 4, 5}, rb.ToSlice())
+	assert.Equal(t, 3, rb.Pop())
+	assert.NoError(t, rb.Push(6))
+	assert.NoError(t, rb.Push(7))
+	assert.Equal(t, []interface{}{5, 6, 7}, rb.ToSlice())
+}
+
+func TestRingBuffer_Reject(t *testing.T) {
+	rb := NewInt(2, Reject)
+	assert.NoError(t, rb.Push(1))
+	assert.NoError(t, rb.Push(2))
+	assert.ErrorIs(t, rb.Push(3), ErrFull)
+	assert.Equal(t, []interface{}{1, 2}, rb.ToSlice())
+}
+
+func TestRingBuffer_Block(t *testing.T) {
+	rb := NewInt(2, Block)
+	assert.NoError(t, rb.Push(1))
+	assert.NoError(t, rb.Push(2))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, rb.PushContext(ctx, 3), context.DeadlineExceeded)
+
+	done := make(chan error)
+	go func() { done <- rb.Push(3) }()
+	select {
+	case <-done:
+		t.Fatal("Push returned before an element was popped")
+	case <-time.After(10 * time.Millisecond):
+	}
+	assert.Equal(t, 1, rb.Pop())
+	assert.NoError(t, <-done)
+	assert.Equal(t, []interface{}{2, 3}, rb.ToSlice())
+
+	go func() { done <- rb.Push(4) }()
+	time.Sleep(10 * time.Millisecond)
+	rb.Clear()
+	assert.NoError(t, <-done)
+	assert.Equal(t, []interface{}{4}, rb.ToSlice())
+}
+
+func TestRingBuffer_Block_Concurrent(t *testing.T) {
+	rb := NewInt(4, Block)
+	const count = 1000
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < count; i++ {
+			assert.NoError(t, rb.Push(i))
+		}
+	}()
+	for i := 0; i < count; {
+		value := rb.Pop()
+		if value == nil {
+			runtime.Gosched()
+			continue
+		}
+		assert.Equal(t, i, value)
+		i++
+	}
+	wg.Wait()
+	assert.True(t, rb.Empty())
+}
+
+func TestRingBuffer_Iteration(t *testing.T) {
+	rb := NewInt(3, Overwrite)
+	for i := 1; i <= 4; i++ {
+		_ = rb.Push(i)
+	}
+
+	var values []interface{}
+	for i, value := range rb.All() {
+		assert.Equal(t, int64(len(values)), i)
+		values = append(values, value)
+	}
+	assert.Equal(t, []interface{}{2, 3, 4}, values)
+
+	values = nil
+	for it := rb.Iter(); it.HasNext(); {
+		values = append(values, it.Next())
+	}
+	assert.Equal(t, []interface{}{2, 3, 4}, values)
+}
+
+func TestPolicy_String(t *testing.T) {
+	assert.Equal(t, "Overwrite", Overwrite.String())
+	assert.Equal(t, "Reject", Reject.String())
+	assert.Equal(t, "Block", Block.String())
+	assert.Equal(t, "Policy(7)", Policy(7).String())
+}
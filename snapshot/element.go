@@ -0,0 +1,170 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package snapshot
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+	"math"
+	"time"
+)
+
+// encodeElement returns the binary form of the Container. The built-in containers are encoded in a compact binary
+// form, whereas the other Containers are encoded as JSON.
+func encodeElement(value containers.Container) ([]byte, error) {
+	switch v := value.(type) {
+	case containers.IntContainer:
+		return binary.AppendVarint(nil, int64(v)), nil
+	case containers.Int64Container:
+		return binary.AppendVarint(nil, int64(v)), nil
+	case containers.Int8Container:
+		return binary.AppendVarint(nil, int64(v)), nil
+	case containers.Int16Container:
+		return binary.AppendVarint(nil, int64(v)), nil
+	case containers.Int32Container:
+		return binary.AppendVarint(nil, int64(v)), nil
+	case containers.RuneContainer:
+		return binary.AppendVarint(nil, int64(v)), nil
+	case containers.DurationContainer:
+		return binary.AppendVarint(nil, int64(v)), nil
+	case containers.Uint64Container:
+		return binary.AppendUvarint(nil, uint64(v)), nil
+	case containers.UintContainer:
+		return binary.AppendUvarint(nil, uint64(v)), nil
+	case containers.Uint8Container:
+		return binary.AppendUvarint(nil, uint64(v)), nil
+	case containers.Uint16Container:
+		return binary.AppendUvarint(nil, uint64(v)), nil
+	case containers.Uint32Container:
+		return binary.AppendUvarint(nil, uint64(v)), nil
+	case containers.Float64Container:
+		return binary.BigEndian.AppendUint64(nil, math.Float64bits(float64(v))), nil
+	case containers.Float32Container:
+		return binary.BigEndian.AppendUint32(nil, math.Float32bits(float32(v))), nil
+	case containers.BoolContainer:
+		if v {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case containers.StringContainer:
+		return []byte(v), nil
+	case containers.BytesContainer:
+		return []byte(v), nil
+	case containers.TimeContainer:
+		return time.Time(v).MarshalBinary()
+	default:
+		return json.Marshal(containers.CleanBasicType(value))
+	}
+}
+
+// decodeElement decodes the binary form of an element into a Container of the valueType's type.
+func decodeElement(valueType containers.Container, data []byte) (containers.Container, error) {
+	switch valueType.(type) {
+	case containers.IntContainer, containers.Int64Container, containers.Int8Container, containers.Int16Container,
+		containers.Int32Container, containers.RuneContainer, containers.DurationContainer:
+		x, n := binary.Varint(data)
+		if n <= 0 || n != len(data) {
+			return nil, invalidElement(valueType, data)
+		}
+		return decodeInteger(valueType, x), nil
+	case containers.Uint64Container, containers.UintContainer, containers.Uint8Container, containers.Uint16Container,
+		containers.Uint32Container:
+		x, n := binary.Uvarint(data)
+		if n <= 0 || n != len(data) {
+			return nil, invalidElement(valueType, data)
+		}
+		return decodeUnsigned(valueType, x), nil
+	case containers.Float64Container:
+		if len(data) != 8 {
+			return nil, invalidElement(valueType, data)
+		}
+		return containers.Float64Container(math.Float64frombits(binary.BigEndian.Uint64(data))), nil
+	case containers.Float32Container:
+		if len(data) != 4 {
+			return nil, invalidElement(valueType, data)
+		}
+		return containers.Float32Container(math.Float32frombits(binary.BigEndian.Uint32(data))), nil
+	case containers.BoolContainer:
+		if len(data) != 1 || data[0] > 1 {
+			return nil, invalidElement(valueType, data)
+		}
+		return containers.BoolContainer(data[0] == 1), nil
+	case containers.StringContainer:
+		return containers.StringContainer(data), nil
+	case containers.BytesContainer:
+		// The data is copied, since the decoder reuses its buffer
+		return containers.BytesContainer(append([]byte{}, data...)), nil
+	case containers.TimeContainer:
+		var t time.Time
+		if err := t.UnmarshalBinary(data); err != nil {
+			return nil, err
+		}
+		return containers.TimeContainer(t), nil
+	default:
+		return containers.UnmarshalJSON(valueType, data)
+	}
+}
+
+// decodeInteger converts the integer into a Container of the valueType's type.
+func decodeInteger(valueType containers.Container, x int64) containers.Container {
+	switch valueType.(type) {
+	case containers.IntContainer:
+		return containers.IntContainer(x)
+	case containers.Int64Container:
+		return containers.Int64Container(x)
+	case containers.Int8Container:
+		return containers.Int8Container(x)
+	case containers.Int16Container:
+		return containers.Int16Container(x)
+	case containers.Int32Container:
+		return containers.Int32Container(x)
+	case containers.RuneContainer:
+		return containers.RuneContainer(x)
+	default:
+		return containers.DurationContainer(x)
+	}
+}
+
+// decodeUnsigned converts the unsigned integer into a Container of the valueType's type.
+func decodeUnsigned(valueType containers.Container, x uint64) containers.Container {
+	switch valueType.(type) {
+	case containers.UintContainer:
+		return containers.UintContainer(x)
+	case containers.Uint8Container:
+		return containers.Uint8Container(x)
+	case containers.Uint16Container:
+		return containers.Uint16Container(x)
+	case containers.Uint32Container:
+		return containers.Uint32Container(x)
+	default:
+		return containers.Uint64Container(x)
+	}
+}
+
+func invalidElement(valueType containers.Container, data []byte) error {
+	return fmt.Errorf("%w: invalid %T element %x", ErrInvalidFormat, valueType, data)
+}
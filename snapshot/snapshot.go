@@ -0,0 +1,256 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package snapshot implements the compact, versioned binary format used by the gollections data-structures to
+// persist their elements, e.g., through their Snapshot/Restore or GobEncode/GobDecode methods.
+//
+// A snapshot is laid out as follows, where the checksum is the CRC-32 (IEEE) of all the preceding bytes, and hence
+// detects truncated or corrupted snapshots:
+//
+//	magic    [4]byte   "GOLS"
+//	version  uint8
+//	kind     uint8     the kind of data-structure encoded
+//	count    uvarint   the number of elements
+//	elements           count length-prefixed (uvarint) elements, each optionally followed by varint fields
+//	checksum uint32    big endian
+//
+// The built-in containers are encoded in a compact binary form (e.g. varints for integers), whereas the other
+// Containers are encoded as JSON.
+package snapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/soheltarir/gollections/containers"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+// Version is the version of the binary format written by the Encoder.
+const Version uint8 = 1
+
+var magic = [4]byte{'G', 'O', 'L', 'S'}
+
+// Kind identifies the data-structure encoded in a snapshot.
+type Kind uint8
+
+const (
+	// KindList is the kind of lists, queues & stacks; the elements are encoded from the front to the back.
+	KindList Kind = iota + 1
+	// KindCounter is the kind of counters; every element is followed by its count.
+	KindCounter
+	// KindHeap is the kind of heaps; the elements are encoded in the heap's internal order.
+	KindHeap
+	// KindTree is the kind of binary trees; the elements are encoded in the level order.
+	KindTree
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindList:
+		return "list"
+	case KindCounter:
+		return "counter"
+	case KindHeap:
+		return "heap"
+	case KindTree:
+		return "tree"
+	default:
+		return fmt.Sprintf("Kind(%d)", uint8(k))
+	}
+}
+
+var (
+	// ErrInvalidFormat is returned when the data being decoded is not a snapshot of the expected kind.
+	ErrInvalidFormat = errors.New("snapshot: invalid format")
+	// ErrUnsupportedVersion is returned when the snapshot was written by a newer version of the binary format.
+	ErrUnsupportedVersion = errors.New("snapshot: unsupported version")
+	// ErrTruncated is returned when the snapshot ends unexpectedly.
+	ErrTruncated = errors.New("snapshot: truncated")
+	// ErrChecksumMismatch is returned when the checksum of the snapshot doesn't match its contents.
+	ErrChecksumMismatch = errors.New("snapshot: checksum mismatch")
+)
+
+/** Encoder **/
+
+// Encoder writes a snapshot to an io.Writer. The header is written by NewEncoder, followed by the elements using
+// WriteElement (& WriteVarint), and Close writes the checksum. The first error encountered is retained and returned
+// by the subsequent calls.
+type Encoder struct {
+	w    io.Writer
+	hash hash.Hash32
+	buf  []byte
+	err  error
+}
+
+// NewEncoder writes the header of a snapshot of the kind provided containing count elements.
+func NewEncoder(w io.Writer, kind Kind, count int64) *Encoder {
+	e := &Encoder{w: w, hash: crc32.NewIEEE()}
+	header := make([]byte, 0, len(magic)+2+binary.MaxVarintLen64)
+	header = append(header, magic[:]...)
+	header = append(header, Version, uint8(kind))
+	e.write(binary.AppendUvarint(header, uint64(count)))
+	return e
+}
+
+func (e *Encoder) write(data []byte) {
+	if e.err != nil {
+		return
+	}
+	if _, e.err = e.w.Write(data); e.err == nil {
+		e.hash.Write(data)
+	}
+}
+
+// WriteElement writes the length-prefixed binary form of the Container.
+func (e *Encoder) WriteElement(value containers.Container) error {
+	if e.err != nil {
+		return e.err
+	}
+	encoded, err := encodeElement(value)
+	if err != nil {
+		e.err = err
+		return err
+	}
+	e.buf = binary.AppendUvarint(e.buf[:0], uint64(len(encoded)))
+	e.buf = append(e.buf, encoded...)
+	e.write(e.buf)
+	return e.err
+}
+
+// WriteVarint writes a signed integer field, e.g., the count of a counter's element.
+func (e *Encoder) WriteVarint(x int64) error {
+	e.buf = binary.AppendVarint(e.buf[:0], x)
+	e.write(e.buf)
+	return e.err
+}
+
+// Close writes the checksum of the snapshot. It doesn't close the underlying io.Writer.
+func (e *Encoder) Close() error {
+	e.write(binary.BigEndian.AppendUint32(nil, e.hash.Sum32()))
+	return e.err
+}
+
+/** Decoder **/
+
+// Decoder reads a snapshot from an io.Reader. The header is read by NewDecoder, followed by the elements using
+// ReadElement (& ReadVarint), and Close verifies the checksum. Only the bytes of the snapshot are consumed from the
+// io.Reader, hence a snapshot can be followed by other data.
+type Decoder struct {
+	r    io.Reader
+	hash hash.Hash32
+	buf  bytes.Buffer
+	// Count is the number of elements in the snapshot
+	Count int64
+}
+
+// NewDecoder reads the header of a snapshot, returning ErrInvalidFormat if the snapshot is not of the kind provided.
+func NewDecoder(r io.Reader, kind Kind) (*Decoder, error) {
+	d := &Decoder{r: r, hash: crc32.NewIEEE()}
+	header := make([]byte, len(magic)+2)
+	if err := d.read(header); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(magic)], magic[:]) {
+		return nil, fmt.Errorf("%w: missing the snapshot header", ErrInvalidFormat)
+	}
+	if version := header[len(magic)]; version == 0 || version > Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	if actual := Kind(header[len(magic)+1]); actual != kind {
+		return nil, fmt.Errorf("%w: expected a %s snapshot, received %s", ErrInvalidFormat, kind, actual)
+	}
+	count, err := binary.ReadUvarint(d)
+	if err != nil {
+		return nil, truncated(err)
+	}
+	d.Count = int64(count)
+	return d, nil
+}
+
+// read fills the buffer provided from the snapshot.
+func (d *Decoder) read(buf []byte) error {
+	if _, err := io.ReadFull(d.r, buf); err != nil {
+		return truncated(err)
+	}
+	d.hash.Write(buf)
+	return nil
+}
+
+// ReadByte implements io.ByteReader, required to read varints.
+func (d *Decoder) ReadByte() (byte, error) {
+	var b [1]byte
+	if err := d.read(b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// ReadElement reads a length-prefixed element, and decodes it into a Container of the valueType's type.
+func (d *Decoder) ReadElement(valueType containers.Container) (containers.Container, error) {
+	length, err := binary.ReadUvarint(d)
+	if err != nil {
+		return nil, truncated(err)
+	}
+	// The buffer grows as the data is read, instead of trusting the length prefix of a possibly corrupted snapshot
+	d.buf.Reset()
+	if _, err := io.CopyN(&d.buf, d.r, int64(length)); err != nil {
+		return nil, truncated(err)
+	}
+	d.hash.Write(d.buf.Bytes())
+	return decodeElement(valueType, d.buf.Bytes())
+}
+
+// ReadVarint reads a signed integer field written by Encoder.WriteVarint.
+func (d *Decoder) ReadVarint() (int64, error) {
+	x, err := binary.ReadVarint(d)
+	if err != nil {
+		return 0, truncated(err)
+	}
+	return x, nil
+}
+
+// Close reads & verifies the checksum of the snapshot, returning ErrChecksumMismatch if the snapshot is corrupted.
+func (d *Decoder) Close() error {
+	expected := d.hash.Sum32()
+	var checksum [4]byte
+	if _, err := io.ReadFull(d.r, checksum[:]); err != nil {
+		return truncated(err)
+	}
+	if binary.BigEndian.Uint32(checksum[:]) != expected {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
+
+// truncated reports an unexpected end of the snapshot as ErrTruncated.
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrTruncated
+	}
+	return err
+}
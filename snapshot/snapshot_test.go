@@ -0,0 +1,192 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+	"time"
+)
+
+type user struct {
+	ID   string
+	Name string
+}
+
+func (u user) Key() interface{} {
+	return u.ID
+}
+
+func (u user) Less(x containers.Container) bool {
+	return u.ID < x.(user).ID
+}
+
+func (u user) Validate(x interface{}) containers.Container {
+	return x.(user)
+}
+
+func encode(t *testing.T, kind Kind, values ...containers.Container) []byte {
+	var buf bytes.Buffer
+	encoder := NewEncoder(&buf, kind, int64(len(values)))
+	for _, value := range values {
+		assert.NoError(t, encoder.WriteElement(value))
+	}
+	assert.NoError(t, encoder.Close())
+	return buf.Bytes()
+}
+
+func decode(valueType containers.Container, kind Kind, data []byte) ([]containers.Container, error) {
+	decoder, err := NewDecoder(bytes.NewReader(data), kind)
+	if err != nil {
+		return nil, err
+	}
+	var values []containers.Container
+	for i := int64(0); i < decoder.Count; i++ {
+		value, err := decoder.ReadElement(valueType)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, decoder.Close()
+}
+
+func TestRoundTrip(t *testing.T) {
+	now := time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC)
+	testCases := [][]containers.Container{
+		{containers.IntContainer(0), containers.IntContainer(-1), containers.IntContainer(1 << 40)},
+		{containers.Int64Container(-7), containers.Int64Container(7)},
+		{containers.Uint64Container(0), containers.Uint64Container(1<<64 - 1)},
+		{containers.RuneContainer('a'), containers.RuneContainer('世')},
+		{containers.Int8Container(-128), containers.Int8Container(127)},
+		{containers.Int16Container(-300), containers.Int16Container(300)},
+		{containers.Int32Container(-1 << 31), containers.Int32Container(1<<31 - 1)},
+		{containers.UintContainer(0), containers.UintContainer(1 << 40)},
+		{containers.Uint8Container(0), containers.Uint8Container(255)},
+		{containers.Uint16Container(1), containers.Uint16Container(1<<16 - 1)},
+		{containers.Uint32Container(1), containers.Uint32Container(1<<32 - 1)},
+		{containers.Float32Container(1.5), containers.Float32Container(-0.25)},
+		{containers.DurationContainer(time.Second), containers.DurationContainer(-time.Hour)},
+		{containers.Float64Container(1.5), containers.Float64Container(-0.25)},
+		{containers.BoolContainer(true), containers.BoolContainer(false)},
+		{containers.StringContainer(""), containers.StringContainer("gollections")},
+		{containers.BytesContainer("go"), containers.BytesContainer("lang")},
+		{containers.TimeContainer(now), containers.TimeContainer(now.Add(time.Minute))},
+		{user{ID: "1", Name: "John Wick"}, user{ID: "2", Name: "Hanzo Hashashi"}},
+	}
+	for _, values := range testCases {
+		decoded, err := decode(values[0], KindList, encode(t, KindList, values...))
+		assert.NoError(t, err)
+		assert.Equal(t, values, decoded)
+	}
+}
+
+func TestRoundTrip_Empty(t *testing.T) {
+	data := encode(t, KindHeap)
+	// magic, version, kind, count & checksum
+	assert.Equal(t, 4+1+1+1+4, len(data))
+	decoded, err := decode(containers.IntContainer(0), KindHeap, data)
+	assert.NoError(t, err)
+	assert.Empty(t, decoded)
+}
+
+func TestVarintFields(t *testing.T) {
+	var buf bytes.Buffer
+	encoder := NewEncoder(&buf, KindCounter, 1)
+	assert.NoError(t, encoder.WriteElement(containers.StringContainer("a")))
+	assert.NoError(t, encoder.WriteVarint(-3))
+	assert.NoError(t, encoder.Close())
+
+	decoder, err := NewDecoder(&buf, KindCounter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), decoder.Count)
+	element, err := decoder.ReadElement(containers.StringContainer(""))
+	assert.NoError(t, err)
+	assert.Equal(t, containers.StringContainer("a"), element)
+	count, err := decoder.ReadVarint()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-3), count)
+	assert.NoError(t, decoder.Close())
+}
+
+func TestNewDecoder_InvalidHeader(t *testing.T) {
+	data := encode(t, KindList, containers.IntContainer(1))
+
+	_, err := NewDecoder(bytes.NewReader(data), KindCounter)
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	corrupted := append([]byte{}, data...)
+	corrupted[0] = 'X'
+	_, err = NewDecoder(bytes.NewReader(corrupted), KindList)
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	corrupted = append([]byte{}, data...)
+	corrupted[4] = Version + 1
+	_, err = NewDecoder(bytes.NewReader(corrupted), KindList)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestDecoder_Truncated(t *testing.T) {
+	data := encode(t, KindList, containers.StringContainer("a"), containers.StringContainer("bc"))
+	for i := 0; i < len(data); i++ {
+		_, err := decode(containers.StringContainer(""), KindList, data[:i])
+		assert.ErrorIs(t, err, ErrTruncated, "truncated at %d bytes", i)
+	}
+}
+
+func TestDecoder_ChecksumMismatch(t *testing.T) {
+	data := encode(t, KindList, containers.StringContainer("abc"))
+	corrupted := append([]byte{}, data...)
+	// Flip a byte of the element
+	corrupted[len(corrupted)-6] ^= 0xff
+	_, err := decode(containers.StringContainer(""), KindList, corrupted)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+}
+
+func TestDecoder_InvalidElement(t *testing.T) {
+	data := encode(t, KindList, containers.StringContainer("abc"))
+	_, err := decode(containers.BoolContainer(false), KindList, data)
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+	_, err = decode(containers.Float64Container(0), KindList, data)
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+	_, err = decode(containers.IntContainer(0), KindList, encode(t, KindList, containers.Float64Container(1)))
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+	_, err = decode(user{}, KindList, data)
+	assert.Error(t, err)
+}
+
+func TestDecoder_TrailingData(t *testing.T) {
+	data := encode(t, KindList, containers.IntContainer(1))
+	r := bytes.NewReader(append(data, "trailing"...))
+	_, err := decode(containers.IntContainer(0), KindList, data)
+	assert.NoError(t, err)
+
+	decoder, err := NewDecoder(r, KindList)
+	assert.NoError(t, err)
+	_, err = decoder.ReadElement(containers.IntContainer(0))
+	assert.NoError(t, err)
+	assert.NoError(t, decoder.Close())
+	// Only the snapshot is consumed from the reader
+	rest, _ := io.ReadAll(r)
+	assert.Equal(t, "trailing", string(rest))
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestEncoder_WriteError(t *testing.T) {
+	encoder := NewEncoder(failingWriter{}, KindList, 1)
+	assert.EqualError(t, encoder.WriteElement(containers.IntContainer(1)), "disk full")
+	assert.EqualError(t, encoder.Close(), "disk full")
+}
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "list", KindList.String())
+	assert.Equal(t, "tree", KindTree.String())
+	assert.Equal(t, "Kind(42)", Kind(42).String())
+}
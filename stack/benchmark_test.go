@@ -0,0 +1,50 @@
+package stack
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"testing"
+)
+
+const benchmarkSize = 10000
+
+func benchmarkStack(b *testing.B, constructor func(containers.Container, ...interface{}) *Stack) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s := constructor(containers.IntContainer(0))
+		for j := 0; j < benchmarkSize; j++ {
+			s.Push(j)
+		}
+		for !s.Empty() {
+			s.Pop()
+		}
+	}
+}
+
+func benchmarkStackSteady(b *testing.B, constructor func(containers.Container, ...interface{}) *Stack) {
+	s := constructor(containers.IntContainer(0))
+	for j := 0; j < 64; j++ {
+		s.Push(j)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Push(i)
+		s.Pop()
+	}
+}
+
+func BenchmarkStack_PushPop(b *testing.B) {
+	benchmarkStack(b, New)
+}
+
+func BenchmarkStackWithDeque_PushPop(b *testing.B) {
+	benchmarkStack(b, NewWithDeque)
+}
+
+func BenchmarkStack_Steady(b *testing.B) {
+	benchmarkStackSteady(b, New)
+}
+
+func BenchmarkStackWithDeque_Steady(b *testing.B) {
+	benchmarkStackSteady(b, NewWithDeque)
+}
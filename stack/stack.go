@@ -29,14 +29,38 @@ SOFTWARE.
 package stack
 
 import (
+	"github.com/soheltarir/gollections"
 	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/deque"
 	"github.com/soheltarir/gollections/lists"
+	"io"
+	"iter"
 )
 
+// storage is the sequence container the elements of a stack are stored in, either a lists.LinkedList or a
+// deque.Deque
+type storage interface {
+	Back() interface{}
+	Backward() iter.Seq2[int64, interface{}]
+	PushBack(value interface{})
+	TryPushBack(value interface{}) error
+	PopBack() interface{}
+	Clear()
+	Size() int64
+	Empty() bool
+	ToSlice() []interface{}
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(data []byte) error
+	Snapshot(w io.Writer) error
+	Restore(r io.Reader) error
+	GobEncode() ([]byte, error)
+	GobDecode(data []byte) error
+}
+
 // Stack is a type of container adaptor, specifically designed to operate in a LIFO context (last-in first-out),
 // where elements are inserted and extracted only from one end of the container.
 type Stack struct {
-	data *lists.LinkedList
+	data storage
 }
 
 // Push Inserts a new element at the top of the stack, above its current top element.
@@ -44,6 +68,12 @@ func (s *Stack) Push(value interface{}) {
 	s.data.PushBack(value)
 }
 
+// TryPush is similar to Push, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (s *Stack) TryPush(value interface{}) error {
+	return s.data.TryPushBack(value)
+}
+
 // Pop Removes the element on top of the stack, effectively reducing its size by one.
 // The element removed is the latest element inserted into the stack, whose value can be retrieved by calling
 // method Stack::Top.
@@ -72,6 +102,75 @@ func (s *Stack) Clear() {
 	s.data.Clear()
 }
 
+// ToSlice returns the elements of the stack from the top to the bottom, i.e., in the order they would be popped.
+func (s *Stack) ToSlice() []interface{} {
+	values := s.data.ToSlice()
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+	return values
+}
+
+// Values returns an iterator over the elements of the stack from the top to the bottom, i.e., in the order they
+// would be popped. The stack must not be modified within the loop.
+func (s *Stack) Values() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for _, value := range s.data.Backward() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the stack from the top to the bottom.
+func (s *Stack) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(s.ToSlice())
+}
+
+// MarshalJSON implements json.Marshaler; the stack is encoded as a JSON array of its elements from the bottom to the
+// top, i.e., in the order they were pushed, so that decoding the array restores the same stack.
+func (s *Stack) MarshalJSON() ([]byte, error) {
+	return s.data.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler; decodes a JSON array (bottom to top) into the elements of the stack,
+// replacing its current contents. The stack must be created using New (or one of the NewX constructors) beforehand.
+func (s *Stack) UnmarshalJSON(data []byte) error {
+	if s.data == nil {
+		return containers.ErrNoValueType
+	}
+	return s.data.UnmarshalJSON(data)
+}
+
+// Snapshot writes the elements of the stack from the bottom to the top in the binary format of the snapshot package.
+func (s *Stack) Snapshot(w io.Writer) error {
+	return s.data.Snapshot(w)
+}
+
+// Restore reads a snapshot written by Snapshot, replacing the current contents of the stack. The stack must be
+// created using New (or one of the NewX constructors) beforehand, and is left unmodified in case of an error.
+func (s *Stack) Restore(r io.Reader) error {
+	if s.data == nil {
+		return containers.ErrNoValueType
+	}
+	return s.data.Restore(r)
+}
+
+// GobEncode implements gob.GobEncoder using the binary format written by Snapshot.
+func (s *Stack) GobEncode() ([]byte, error) {
+	return s.data.GobEncode()
+}
+
+// GobDecode implements gob.GobDecoder; the stack must be created using New (or one of the NewX constructors)
+// beforehand.
+func (s *Stack) GobDecode(data []byte) error {
+	if s.data == nil {
+		return containers.ErrNoValueType
+	}
+	return s.data.GobDecode(data)
+}
+
 // New instantiates a fresh stack with the values provided
 func New(valueType containers.Container, values ...interface{}) *Stack {
 	list := lists.New(valueType)
@@ -79,6 +178,12 @@ func New(valueType containers.Container, values ...interface{}) *Stack {
 	return &Stack{data: list}
 }
 
+// NewWithDeque instantiates a fresh stack with the values provided, storing its elements in a deque.Deque instead of
+// a linked list. The elements are stored contiguously in a ring buffer, avoiding the allocation of a node per element.
+func NewWithDeque(valueType containers.Container, values ...interface{}) *Stack {
+	return &Stack{data: deque.New(valueType, values...)}
+}
+
 // NewInt constructs a stack containing only integer elements
 func NewInt(values ...interface{}) *Stack {
 	return New(containers.IntContainer(0), values...)
@@ -88,3 +193,83 @@ func NewInt(values ...interface{}) *Stack {
 func NewString(values ...interface{}) *Stack {
 	return New(containers.StringContainer(""), values...)
 }
+
+// NewFloat64 constructs a stack containing only float64 elements
+func NewFloat64(values ...interface{}) *Stack {
+	return New(containers.Float64Container(0), values...)
+}
+
+// NewInt64 constructs a stack containing only int64 elements
+func NewInt64(values ...interface{}) *Stack {
+	return New(containers.Int64Container(0), values...)
+}
+
+// NewUint64 constructs a stack containing only uint64 elements
+func NewUint64(values ...interface{}) *Stack {
+	return New(containers.Uint64Container(0), values...)
+}
+
+// NewInt8 constructs a stack containing only int8 elements
+func NewInt8(values ...interface{}) *Stack {
+	return New(containers.Int8Container(0), values...)
+}
+
+// NewInt16 constructs a stack containing only int16 elements
+func NewInt16(values ...interface{}) *Stack {
+	return New(containers.Int16Container(0), values...)
+}
+
+// NewInt32 constructs a stack containing only int32 elements
+func NewInt32(values ...interface{}) *Stack {
+	return New(containers.Int32Container(0), values...)
+}
+
+// NewUint constructs a stack containing only uint elements
+func NewUint(values ...interface{}) *Stack {
+	return New(containers.UintContainer(0), values...)
+}
+
+// NewUint8 constructs a stack containing only uint8 elements
+func NewUint8(values ...interface{}) *Stack {
+	return New(containers.Uint8Container(0), values...)
+}
+
+// NewUint16 constructs a stack containing only uint16 elements
+func NewUint16(values ...interface{}) *Stack {
+	return New(containers.Uint16Container(0), values...)
+}
+
+// NewUint32 constructs a stack containing only uint32 elements
+func NewUint32(values ...interface{}) *Stack {
+	return New(containers.Uint32Container(0), values...)
+}
+
+// NewFloat32 constructs a stack containing only float32 elements
+func NewFloat32(values ...interface{}) *Stack {
+	return New(containers.Float32Container(0), values...)
+}
+
+// NewRune constructs a stack containing only rune elements
+func NewRune(values ...interface{}) *Stack {
+	return New(containers.RuneContainer(0), values...)
+}
+
+// NewBool constructs a stack containing only bool elements
+func NewBool(values ...interface{}) *Stack {
+	return New(containers.BoolContainer(false), values...)
+}
+
+// NewTime constructs a stack containing only time.Time elements
+func NewTime(values ...interface{}) *Stack {
+	return New(containers.TimeContainer{}, values...)
+}
+
+// NewDuration constructs a stack containing only time.Duration elements
+func NewDuration(values ...interface{}) *Stack {
+	return New(containers.DurationContainer(0), values...)
+}
+
+// NewBytes constructs a stack containing only []byte elements
+func NewBytes(values ...interface{}) *Stack {
+	return New(containers.BytesContainer{}, values...)
+}
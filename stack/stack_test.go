@@ -1,7 +1,11 @@
 package stack
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"github.com/soheltarir/gollections/containers"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -77,3 +81,101 @@ func Example() {
 	// d
 	// 3
 }
+
+func TestStack_TryPush(t *testing.T) {
+	s := NewInt()
+	assert.NoError(t, s.TryPush(1))
+	assert.Error(t, s.TryPush("a"))
+	assert.Equal(t, int64(1), s.Size())
+}
+
+func TestNewBool(t *testing.T) {
+	s := NewBool(false, true)
+	assert.Equal(t, true, s.Pop())
+	assert.Panics(t, func() { s.Push(1) })
+}
+
+func TestStack_ToSlice(t *testing.T) {
+	s := NewInt(1, 2, 3)
+	assert.Equal(t, []interface{}{3, 2, 1}, s.ToSlice())
+	it := s.Iter()
+	assert.Equal(t, 3, it.Next())
+}
+
+func TestStack_Values(t *testing.T) {
+	s := NewInt(1, 2, 3)
+	var actual []interface{}
+	for v := range s.Values() {
+		actual = append(actual, v)
+	}
+	assert.Equal(t, []interface{}{3, 2, 1}, actual)
+}
+
+func TestStack_JSON(t *testing.T) {
+	s := NewInt(1, 2, 3)
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	// Encoded from the bottom to the top
+	assert.Equal(t, `[1,2,3]`, string(data))
+
+	decoded := NewInt()
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, 3, decoded.Top())
+	assert.Equal(t, s.ToSlice(), decoded.ToSlice())
+
+	assert.Error(t, json.Unmarshal([]byte(`["one"]`), decoded))
+	var zero Stack
+	assert.ErrorIs(t, json.Unmarshal(data, &zero), containers.ErrNoValueType)
+}
+
+func TestStack_Snapshot(t *testing.T) {
+	s := NewInt(1, 2, 3)
+	var buf bytes.Buffer
+	assert.NoError(t, s.Snapshot(&buf))
+	restored := NewInt()
+	assert.NoError(t, restored.Restore(&buf))
+	assert.Equal(t, 3, restored.Pop())
+	assert.Equal(t, 2, restored.Top())
+
+	var zero Stack
+	assert.ErrorIs(t, zero.Restore(&buf), containers.ErrNoValueType)
+	assert.ErrorIs(t, zero.GobDecode(nil), containers.ErrNoValueType)
+}
+
+func TestStack_Gob(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(NewString("a", "b")))
+	decoded := NewString()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	assert.Equal(t, []interface{}{"b", "a"}, decoded.ToSlice())
+}
+
+func TestNewWithDeque(t *testing.T) {
+	s := NewWithDeque(containers.IntContainer(0), 1, 2)
+	s.Push(3)
+	assert.Equal(t, 3, s.Top())
+	assert.Equal(t, []interface{}{3, 2, 1}, s.ToSlice())
+	assert.Equal(t, 3, s.Pop())
+	assert.Error(t, s.TryPush("a"))
+
+	var values []interface{}
+	for value := range s.Values() {
+		values = append(values, value)
+	}
+	assert.Equal(t, []interface{}{2, 1}, values)
+
+	// Snapshots are interchangeable between the storages
+	var buf bytes.Buffer
+	assert.NoError(t, s.Snapshot(&buf))
+	restored := NewInt()
+	assert.NoError(t, restored.Restore(&buf))
+	assert.Equal(t, s.ToSlice(), restored.ToSlice())
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[1, 2]`, string(data))
+	s.Clear()
+	assert.True(t, s.Empty())
+	assert.NoError(t, json.Unmarshal(data, s))
+	assert.Equal(t, 2, s.Top())
+}
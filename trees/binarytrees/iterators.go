@@ -24,7 +24,12 @@ SOFTWARE.
 
 package binarytrees
 
-import "github.com/soheltarir/gollections/lists"
+import (
+	"errors"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/lists"
+	"iter"
+)
 
 // TreeIterations lists methods to iterate through a binary tree.
 type TreeIterations interface {
@@ -41,38 +46,88 @@ const (
 	BreadthFirstTraversal traversalType = iota
 )
 
-// Iterator is a stateful iterator for traversing a linked list.
-// Please note, that iteration over a list is not a thread-safe operation, and if parallel write operations are
-// being performed on the list, the traversal can provide stale and outdated data.
+// Iterator is a stateful iterator for traversing a binary tree.
+// Iterators are fail-fast; once the tree is modified, Next & Value panic with containers.ErrConcurrentModification
+// instead of traversing stale nodes. TryNext & TryValue return the error instead.
 type Iterator struct {
+	tree          *Tree
 	currentNode   *Node
 	visited       *lists.LinkedList
 	traversalType traversalType
+	// modCount is the modification count of the tree the iterator is valid for
+	modCount uint64
 }
 
 // endIterator is used for signifying the end of an iteration or traversal
 var endIterator = &Iterator{}
 
 // Next returns the iterator to the next node in the binary tree based on the traversal technique.
+// Panics with containers.ErrConcurrentModification if the tree has been modified.
 func (it *Iterator) Next() *Iterator {
+	next, err := it.TryNext()
+	if err != nil {
+		panic(err)
+	}
+	return next
+}
+
+// TryNext is similar to Next, but returns containers.ErrConcurrentModification instead of panicking if the tree
+// has been modified.
+func (it *Iterator) TryNext() (*Iterator, error) {
+	if it.tree != nil {
+		it.tree.mu.RLock()
+		defer it.tree.mu.RUnlock()
+
+		if it.tree.modCount != it.modCount {
+			return nil, containers.ErrConcurrentModification
+		}
+	}
 	switch it.traversalType {
 	case BreadthFirstTraversal:
-		return bfsNext(it)
+		return bfsNext(it), nil
 	default:
 		panic("invalid traversal type received")
 	}
 }
 
 // Value returns the current node's data
+// Panics with containers.ErrConcurrentModification if the tree has been modified.
 func (it *Iterator) Value() interface{} {
-	return it.currentNode.Value
+	value, err := it.TryValue()
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// TryValue is similar to Value, but returns containers.ErrConcurrentModification instead of panicking if the tree
+// has been modified.
+func (it *Iterator) TryValue() (interface{}, error) {
+	if it.tree != nil {
+		it.tree.mu.RLock()
+		defer it.tree.mu.RUnlock()
+
+		if it.tree.modCount != it.modCount {
+			return nil, containers.ErrConcurrentModification
+		}
+	}
+	if it.currentNode == nil {
+		return nil, errors.New("iterator crossed tree's bounds")
+	}
+	return it.currentNode.Value, nil
 }
 
 // BreadthFirstTraverse returns an iterator pointing to the root of the binary tree. The iterator is
 // initialised in such a way that subsequent iterators (by calling Next()) returns tree nodes following the
 // breadth-first traversal algorithm. Refer https://en.wikipedia.org/wiki/Breadth-first_search to know more.
 func (t *Tree) BreadthFirstTraverse() *Iterator {
-	return &Iterator{currentNode: t.Root, visited: lists.New(Node{})}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.Root == nil {
+		return endIterator
+	}
+	return &Iterator{tree: t, currentNode: t.Root, visited: lists.New(Node{}), modCount: t.modCount}
 }
 
 // End returns an iterator to the past-the-end node in the binary tree.
@@ -135,6 +190,16 @@ func postorderTraversalAuxiliary(node *Node, result []interface{}) []interface{}
 	return result
 }
 
+// levelOrderValues returns the data of the nodes of the tree rooted at the node provided, in the breadth-first order.
+func levelOrderValues(root *Node) []interface{} {
+	var values []interface{}
+	yieldLevelOrder(root, func(value interface{}) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
 /*****************************************************************************************************/
 
 func (t *Tree) InOrderTraversal() []interface{} {
@@ -151,3 +216,92 @@ func (t *Tree) PostOrderTraversal() []interface{} {
 	var result []interface{}
 	return postorderTraversalAuxiliary(t.Root, result)
 }
+
+// yieldInOrder yields the data of the subtree's nodes InOrder depth first, and reports whether to continue.
+func yieldInOrder(node *Node, yield func(interface{}) bool) bool {
+	if node == nil {
+		return true
+	}
+	return yieldInOrder(node.Left, yield) &&
+		yield(containers.CleanBasicType(node.Value)) &&
+		yieldInOrder(node.Right, yield)
+}
+
+// yieldPreOrder yields the data of the subtree's nodes PreOrder depth first, and reports whether to continue.
+func yieldPreOrder(node *Node, yield func(interface{}) bool) bool {
+	if node == nil {
+		return true
+	}
+	return yield(containers.CleanBasicType(node.Value)) &&
+		yieldPreOrder(node.Left, yield) &&
+		yieldPreOrder(node.Right, yield)
+}
+
+// yieldPostOrder yields the data of the subtree's nodes PostOrder depth first, and reports whether to continue.
+func yieldPostOrder(node *Node, yield func(interface{}) bool) bool {
+	if node == nil {
+		return true
+	}
+	return yieldPostOrder(node.Left, yield) &&
+		yieldPostOrder(node.Right, yield) &&
+		yield(containers.CleanBasicType(node.Value))
+}
+
+// yieldLevelOrder yields the data of the subtree's nodes breadth first, and reports whether to continue.
+func yieldLevelOrder(root *Node, yield func(interface{}) bool) bool {
+	if root == nil {
+		return true
+	}
+	level := []*Node{root}
+	for len(level) > 0 {
+		var nextLevel []*Node
+		for _, node := range level {
+			if !yield(containers.CleanBasicType(node.Value)) {
+				return false
+			}
+			if node.Left != nil {
+				nextLevel = append(nextLevel, node.Left)
+			}
+			if node.Right != nil {
+				nextLevel = append(nextLevel, node.Right)
+			}
+		}
+		level = nextLevel
+	}
+	return true
+}
+
+// sequence returns an iterator over the tree using the traversal provided. The tree is read-locked for the duration
+// of the iteration, and the lock is released once the loop completes or breaks.
+func (t *Tree) sequence(traverse func(*Node, func(interface{}) bool) bool) iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		t.mu.RLock()
+		defer t.mu.RUnlock()
+
+		traverse(t.Root, yield)
+	}
+}
+
+// InOrder returns an iterator over the tree's data traversed InOrder depth first.
+// The tree must not be modified within the loop.
+func (t *Tree) InOrder() iter.Seq[interface{}] {
+	return t.sequence(yieldInOrder)
+}
+
+// PreOrder returns an iterator over the tree's data traversed PreOrder depth first.
+// The tree must not be modified within the loop.
+func (t *Tree) PreOrder() iter.Seq[interface{}] {
+	return t.sequence(yieldPreOrder)
+}
+
+// PostOrder returns an iterator over the tree's data traversed PostOrder depth first.
+// The tree must not be modified within the loop.
+func (t *Tree) PostOrder() iter.Seq[interface{}] {
+	return t.sequence(yieldPostOrder)
+}
+
+// LevelOrder returns an iterator over the tree's data traversed breadth first.
+// The tree must not be modified within the loop.
+func (t *Tree) LevelOrder() iter.Seq[interface{}] {
+	return t.sequence(yieldLevelOrder)
+}
@@ -1,6 +1,7 @@
 package binarytrees
 
 import (
+	"github.com/soheltarir/gollections/containers"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -36,3 +37,88 @@ func TestIterator_Next(t *testing.T) {
 		it.Next()
 	})
 }
+
+func collect(seq func(func(interface{}) bool)) []interface{} {
+	var result []interface{}
+	for v := range seq {
+		result = append(result, v)
+	}
+	return result
+}
+
+func TestTree_Sequences(t *testing.T) {
+	tree := NewInt()
+	tree.InsertMany(10, 9, 6, 5, 11, 20)
+	assert.Equal(t, tree.InOrderTraversal(), collect(tree.InOrder()))
+	assert.Equal(t, tree.PreOrderTraversal(), collect(tree.PreOrder()))
+	assert.Equal(t, tree.PostOrderTraversal(), collect(tree.PostOrder()))
+	assert.Equal(t, []interface{}{10, 9, 6, 5, 11, 20}, collect(tree.LevelOrder()))
+	assert.Empty(t, collect(NewInt().InOrder()))
+}
+
+func TestTree_SequencesBreak(t *testing.T) {
+	tree := NewInt()
+	tree.InsertMany(1, 2, 3)
+	for _, seq := range []func(func(interface{}) bool){tree.InOrder(), tree.PreOrder(), tree.PostOrder(), tree.LevelOrder()} {
+		for range seq {
+			break
+		}
+	}
+	// The read lock should have been released by the early breaks
+	tree.Insert(4)
+	assert.Equal(t, int64(4), tree.Size())
+}
+
+func TestIterator_FailFast(t *testing.T) {
+	tree := NewInt()
+	tree.InsertMany(1, 2, 3)
+	it := tree.BreadthFirstTraverse()
+	assert.Equal(t, containers.IntContainer(1), it.Value())
+	it = it.Next()
+	tree.Insert(4)
+
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { it.Next() })
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { it.Value() })
+	_, err := it.TryNext()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+	_, err = it.TryValue()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+
+	it = tree.BreadthFirstTraverse()
+	tree.Clear()
+	_, err = it.TryNext()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+}
+
+func TestIterator_TryNext(t *testing.T) {
+	tree := NewInt()
+	tree.InsertMany(1, 2)
+	var actual []interface{}
+	for it := tree.BreadthFirstTraverse(); it != tree.End(); {
+		value, err := it.TryValue()
+		assert.NoError(t, err)
+		actual = append(actual, value)
+		it, err = it.TryNext()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []interface{}{containers.IntContainer(1), containers.IntContainer(2)}, actual)
+
+	_, err := tree.End().TryValue()
+	assert.Error(t, err)
+	assert.Equal(t, tree.End(), NewInt().BreadthFirstTraverse())
+}
+
+func TestTree_Modify(t *testing.T) {
+	tree := NewInt()
+	tree.Insert(1)
+	it := tree.BreadthFirstTraverse()
+	tree.Modify(func(root *Node, height int, size int64) (*Node, int, int64) {
+		root.Left = &Node{Value: containers.IntContainer(2)}
+		return root, height + 1, size + 1
+	})
+	assert.Equal(t, []interface{}{1, 2}, tree.ToSlice())
+	assert.Equal(t, 2, tree.Height)
+	assert.Equal(t, int64(2), tree.Size())
+	_, err := it.TryNext()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+}
@@ -0,0 +1,130 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package binarytrees
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/soheltarir/gollections/containers"
+)
+
+// jsonNode is the nested JSON representation of a Node, where the value is decoded lazily once the datatype of the
+// tree is known.
+type jsonNode struct {
+	Value json.RawMessage `json:"value"`
+	Left  *jsonNode       `json:"left,omitempty"`
+	Right *jsonNode       `json:"right,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler; the node is encoded as a JSON object containing the node's value and its
+// left & right subtrees, e.g., {"value": 1, "left": {"value": 2}, "right": {"value": 3}}. Absent children are
+// omitted.
+func (n Node) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value interface{} `json:"value"`
+		Left  *Node       `json:"left,omitempty"`
+		Right *Node       `json:"right,omitempty"`
+	}{Value: containers.CleanBasicType(n.Value), Left: n.Left, Right: n.Right})
+}
+
+// decode converts the nested JSON representation into a subtree containing values of the datatype provided.
+// Returns the root of the subtree along with its height & number of nodes.
+func (n *jsonNode) decode(datatype containers.Container) (*Node, int, int64, error) {
+	if n == nil {
+		return nil, 0, 0, nil
+	}
+	value, err := containers.UnmarshalJSON(datatype, n.Value)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	left, leftHeight, leftSize, err := n.Left.decode(datatype)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	right, rightHeight, rightSize, err := n.Right.decode(datatype)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	height := leftHeight
+	if rightHeight > height {
+		height = rightHeight
+	}
+	return &Node{Value: value, Left: left, Right: right}, height + 1, leftSize + rightSize + 1, nil
+}
+
+// MarshalJSON implements json.Marshaler; the tree is encoded as a JSON array of its values in the breadth-first
+// (level) order. Since the nodes are inserted in the level order, decoding the array restores the same tree.
+// Use NestedJSON to retain the shape of trees built by hand.
+func (t *Tree) MarshalJSON() ([]byte, error) {
+	values := t.ToSlice()
+	if values == nil {
+		// Encode an empty tree as an empty array instead of null
+		values = []interface{}{}
+	}
+	return json.Marshal(values)
+}
+
+// NestedJSON encodes the tree as nested JSON objects starting from the root, e.g.,
+// {"value": 1, "left": {"value": 2}, "right": {"value": 3}}. An empty tree is encoded as null.
+func (t *Tree) NestedJSON() ([]byte, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return json.Marshal(t.Root)
+}
+
+// UnmarshalJSON implements json.Unmarshaler; decodes either a level order JSON array, or the nested form produced
+// by NestedJSON, replacing the current nodes of the tree. The values are decoded into the datatype the tree was
+// initialised with, hence the tree must be created using New (or NewInt) beforehand.
+// The tree is left unmodified in case of an error.
+func (t *Tree) UnmarshalJSON(data []byte) error {
+	if t.datatype == nil {
+		return containers.ErrNoValueType
+	}
+	temp := New(t.datatype)
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		values, err := containers.UnmarshalJSONArray(t.datatype, trimmed)
+		if err != nil {
+			return err
+		}
+		for _, value := range values {
+			temp.insert(value)
+		}
+	} else {
+		var root *jsonNode
+		if err := json.Unmarshal(trimmed, &root); err != nil {
+			return err
+		}
+		var err error
+		if temp.Root, temp.Height, temp.size, err = root.decode(t.datatype); err != nil {
+			return err
+		}
+	}
+
+	t.Modify(func(*Node, int, int64) (*Node, int, int64) {
+		return temp.Root, temp.Height, temp.size
+	})
+	return nil
+}
@@ -0,0 +1,83 @@
+package binarytrees
+
+import (
+	"encoding/json"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTree_MarshalJSON(t *testing.T) {
+	tree := NewInt()
+	data, err := json.Marshal(tree)
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	tree.InsertMany(1, 2, 3, 4)
+	data, err = json.Marshal(tree)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2,3,4]`, string(data))
+}
+
+func TestTree_NestedJSON(t *testing.T) {
+	tree := NewInt()
+	data, err := tree.NestedJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	tree.InsertMany(1, 2, 3, 4)
+	data, err = tree.NestedJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"value":1,"left":{"value":2,"left":{"value":4}},"right":{"value":3}}`, string(data))
+}
+
+func TestTree_UnmarshalJSON(t *testing.T) {
+	tree := NewInt()
+	assert.NoError(t, json.Unmarshal([]byte(`[1, 2, 3, 4]`), tree))
+	assert.Equal(t, []interface{}{1, 2, 3, 4}, tree.ToSlice())
+	assert.Equal(t, int64(4), tree.Size())
+	assert.Equal(t, 3, tree.Height)
+
+	// The nested form retains the shape of the tree
+	assert.NoError(t, json.Unmarshal([]byte(`{"value": 1, "right": {"value": 2, "right": {"value": 3}}}`), tree))
+	assert.Equal(t, 1, containers.ToInt(tree.Root.Value))
+	assert.Nil(t, tree.Root.Left)
+	assert.Equal(t, 3, containers.ToInt(tree.Root.Right.Right.Value))
+	assert.Equal(t, int64(3), tree.Size())
+	assert.Equal(t, 3, tree.Height)
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), tree))
+	assert.True(t, tree.Empty())
+	assert.Nil(t, tree.Root)
+	assert.Equal(t, 0, tree.Height)
+}
+
+func TestTree_UnmarshalJSON_Invalid(t *testing.T) {
+	tree := NewInt()
+	tree.Insert(1)
+	assert.Error(t, json.Unmarshal([]byte(`[1, "two"]`), tree))
+	assert.Error(t, json.Unmarshal([]byte(`{"value": 1, "left": {"value": "two"}}`), tree))
+	assert.Error(t, json.Unmarshal([]byte(`"one"`), tree))
+	// The tree is left unmodified
+	assert.Equal(t, []interface{}{1}, tree.ToSlice())
+
+	var zero Tree
+	assert.ErrorIs(t, json.Unmarshal([]byte(`[1]`), &zero), containers.ErrNoValueType)
+}
+
+func TestTree_JSONRoundTrip(t *testing.T) {
+	original := New(containers.StringContainer(""))
+	original.InsertMany("a", "b", "c", "d", "e")
+	nested, err := original.NestedJSON()
+	assert.NoError(t, err)
+
+	decoded := New(containers.StringContainer(""))
+	assert.NoError(t, json.Unmarshal(nested, decoded))
+	assert.Equal(t, original.InOrderTraversal(), decoded.InOrderTraversal())
+	assert.Equal(t, original.Height, decoded.Height)
+
+	// Insertion continues from the decoded shape
+	decoded.Insert("f")
+	original.Insert("f")
+	assert.Equal(t, original.ToSlice(), decoded.ToSlice())
+}
@@ -25,6 +25,7 @@ SOFTWARE.
 package binarytrees
 
 import (
+	"github.com/soheltarir/gollections"
 	"github.com/soheltarir/gollections/containers"
 	"github.com/soheltarir/gollections/queue"
 	"sync"
@@ -39,22 +40,45 @@ type TreeOperations interface {
 }
 
 // Tree defines the structure of a binary tree
+// The iterators of the tree are fail-fast, i.e., once the tree is modified, the iterators created before the
+// modification report containers.ErrConcurrentModification instead of traversing stale nodes.
 type Tree struct {
 	TreeOperations
 	TreeIterations
 	Root     *Node
 	Height   int
+	size     int64
 	datatype containers.Container
+	// modCount is incremented on every modification of the tree, to invalidate its iterators
+	modCount uint64
 	mu       sync.RWMutex
 }
 
 // Insert adds a node in the tree
+// Panics if an invalid type is provided.
 func (t *Tree) Insert(value interface{}) {
-	newNode := &Node{Value: t.datatype.Validate(value)}
+	t.insert(t.datatype.Validate(value))
+}
+
+// TryInsert is similar to Insert, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (t *Tree) TryInsert(value interface{}) error {
+	element, err := containers.TryValidate(t.datatype, value)
+	if err != nil {
+		return err
+	}
+	t.insert(element)
+	return nil
+}
+
+func (t *Tree) insert(element containers.Container) {
+	newNode := &Node{Value: element}
 
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	t.size++
+	t.modCount++
 	if t.Root == nil {
 		t.Root = newNode
 		t.Height++
@@ -94,6 +118,53 @@ func (t *Tree) InsertMany(values ...interface{}) {
 	}
 }
 
+// Size returns the number of nodes in the tree
+func (t *Tree) Size() int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.size
+}
+
+// Empty reports whether the tree has no nodes
+func (t *Tree) Empty() bool {
+	return t.Size() == 0
+}
+
+// Clear removes all the nodes from the tree
+func (t *Tree) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Root, t.Height, t.size = nil, 0, 0
+	t.modCount++
+}
+
+// Modify replaces the nodes of the tree with the ones returned by the modification, which receives the current
+// root, height & size of the tree. The modification is applied under the tree's write lock, and invalidates the
+// iterators of the tree. It allows the data-structures built on top of the binary tree (e.g. binary search trees)
+// to insert or remove nodes in their own order.
+func (t *Tree) Modify(modification func(root *Node, height int, size int64) (*Node, int, int64)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Root, t.Height, t.size = modification(t.Root, t.Height, t.size)
+	t.modCount++
+}
+
+// ToSlice returns the data of the tree's nodes in the breadth-first (level) order.
+func (t *Tree) ToSlice() []interface{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return levelOrderValues(t.Root)
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the tree in the breadth-first (level) order.
+func (t *Tree) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(t.ToSlice())
+}
+
 // NewInt instantiates a binary tree which can only accept integer as data.
 func NewInt() *Tree {
 	return New(containers.IntContainer(0))
@@ -54,3 +54,20 @@ func TestNode_Validate(t *testing.T) {
 		tester.Validate("a")
 	})
 }
+
+func TestTree_TryInsert(t *testing.T) {
+	tree := NewInt()
+	assert.NoError(t, tree.TryInsert(1))
+	assert.Error(t, tree.TryInsert("one"))
+	assert.Equal(t, 1, tree.Height)
+}
+
+func TestTree_ToSlice(t *testing.T) {
+	tree := NewInt()
+	tree.InsertMany(10, 9, 6, 5)
+	assert.Equal(t, []interface{}{10, 9, 6, 5}, tree.ToSlice())
+	assert.Equal(t, int64(4), tree.Size())
+	tree.Clear()
+	assert.True(t, tree.Empty())
+	assert.Nil(t, tree.Root)
+}
@@ -25,6 +25,7 @@ SOFTWARE.
 package bst
 
 import (
+	"github.com/soheltarir/gollections/containers"
 	"github.com/soheltarir/gollections/trees/binarytrees"
 )
 
@@ -33,6 +34,7 @@ import (
 func insertToTree(
 	root *binarytrees.Node,
 	newNode *binarytrees.Node,
+	comparator containers.Comparator,
 	currHeight int,
 ) (*binarytrees.Node, int) {
 	// Handle base case for recursion
@@ -42,11 +44,11 @@ func insertToTree(
 		}
 		return newNode, currHeight
 	}
-	if root.Value.Less(newNode.Value) {
-		root.Right, currHeight = insertToTree(root.Right, newNode, currHeight)
+	if comparator(root.Value, newNode.Value) {
+		root.Right, currHeight = insertToTree(root.Right, newNode, comparator, currHeight)
 		currHeight++
 	} else {
-		root.Left, currHeight = insertToTree(root.Left, newNode, currHeight)
+		root.Left, currHeight = insertToTree(root.Left, newNode, comparator, currHeight)
 		currHeight++
 	}
 	return root, currHeight
@@ -0,0 +1,73 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package bst
+
+import (
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/trees/binarytrees"
+)
+
+// replace replaces the nodes of the tree with the values provided, inserted in the order provided.
+func (t *Tree) replace(values []containers.Container) {
+	t.Modify(func(*binarytrees.Node, int, int64) (*binarytrees.Node, int, int64) {
+		var (
+			root   *binarytrees.Node
+			height int
+		)
+		for _, value := range values {
+			root, height = insertToTree(root, &binarytrees.Node{Value: value}, t.comparator, 0)
+		}
+		return root, height, int64(len(values))
+	})
+}
+
+// MarshalJSON implements json.Marshaler; the tree is encoded as a JSON array of its values in the breadth-first
+// (level) order, which unlike the sorted order returned by ToSlice, restores the same shape of the tree when decoded.
+// NestedJSON encodes the tree as nested JSON objects instead.
+func (t *Tree) MarshalJSON() ([]byte, error) {
+	return t.Tree.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler; decodes either a level order JSON array, or the nested form produced
+// by NestedJSON, replacing the current nodes of the tree. The values are inserted in the level order, hence the
+// shape of the encoded tree is restored provided it was a valid binary search tree w.r.t. the comparator of the tree.
+// The values are decoded into the datatype the tree was initialised with, hence the tree must be created using one
+// of the constructors beforehand. The tree is left unmodified in case of an error.
+func (t *Tree) UnmarshalJSON(data []byte) error {
+	if t.datatype == nil {
+		return containers.ErrNoValueType
+	}
+	decoded := binarytrees.New(t.datatype)
+	if err := decoded.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	var values []containers.Container
+	for value := range decoded.LevelOrder() {
+		values = append(values, t.datatype.Validate(value))
+	}
+	t.replace(values)
+	return nil
+}
@@ -0,0 +1,63 @@
+package bst
+
+import (
+	"encoding/json"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTree_MarshalJSON(t *testing.T) {
+	tree := NewInt()
+	for _, value := range []int{10, 5, 15, 1, 7} {
+		tree.Insert(value)
+	}
+	data, err := json.Marshal(tree)
+	assert.NoError(t, err)
+	// Encoded in the level order, instead of the sorted order
+	assert.Equal(t, `[10,5,15,1,7]`, string(data))
+
+	data, err = tree.NestedJSON()
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"value":10,"left":{"value":5,"left":{"value":1},"right":{"value":7}},"right":{"value":15}}`,
+		string(data),
+	)
+}
+
+func TestTree_UnmarshalJSON(t *testing.T) {
+	original := NewInt()
+	for _, value := range []int{10, 5, 15, 1, 7, 20} {
+		original.Insert(value)
+	}
+	data, err := json.Marshal(original)
+	assert.NoError(t, err)
+
+	decoded := NewInt()
+	decoded.Insert(100)
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, original.ToSlice(), decoded.ToSlice())
+	assert.Equal(t, original.BreadthFirstSearch(), decoded.BreadthFirstSearch())
+	assert.Equal(t, original.Size(), decoded.Size())
+
+	nested, err := original.NestedJSON()
+	assert.NoError(t, err)
+	decoded = NewInt()
+	assert.NoError(t, json.Unmarshal(nested, decoded))
+	assert.Equal(t, original.BreadthFirstSearch(), decoded.BreadthFirstSearch())
+
+	// The values are ordered by the comparator of the decoding tree
+	reversed := NewFunc(containers.IntContainer(0), containers.Natural.Reverse())
+	assert.NoError(t, json.Unmarshal([]byte(`[1, 2, 3]`), reversed))
+	assert.Equal(t, []interface{}{3, 2, 1}, reversed.ToSlice())
+}
+
+func TestTree_UnmarshalJSON_Invalid(t *testing.T) {
+	tree := NewString()
+	tree.Insert("a")
+	assert.Error(t, json.Unmarshal([]byte(`["b", 1]`), tree))
+	assert.Equal(t, []interface{}{"a"}, tree.ToSlice())
+
+	var zero Tree
+	assert.ErrorIs(t, json.Unmarshal([]byte(`["a"]`), &zero), containers.ErrNoValueType)
+}
@@ -0,0 +1,92 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package bst
+
+import (
+	"bytes"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/snapshot"
+	"io"
+)
+
+// Snapshot writes the elements of the tree in the breadth-first (level) order, in the binary format of the snapshot
+// package. Restoring the elements in the level order restores the same shape of the tree.
+func (t *Tree) Snapshot(w io.Writer) error {
+	var values []containers.Container
+	for value := range t.LevelOrder() {
+		values = append(values, t.datatype.Validate(value))
+	}
+
+	encoder := snapshot.NewEncoder(w, snapshot.KindTree, int64(len(values)))
+	for _, value := range values {
+		if err := encoder.WriteElement(value); err != nil {
+			return err
+		}
+	}
+	return encoder.Close()
+}
+
+// Restore reads a snapshot written by Snapshot, replacing the current nodes of the tree. The elements are decoded
+// into the datatype the tree was initialised with, hence the tree must be created using one of the constructors
+// beforehand. Returns snapshot.ErrTruncated or snapshot.ErrChecksumMismatch if the snapshot is incomplete or
+// corrupted, in which case the tree is left unmodified.
+func (t *Tree) Restore(r io.Reader) error {
+	if t.datatype == nil {
+		return containers.ErrNoValueType
+	}
+	decoder, err := snapshot.NewDecoder(r, snapshot.KindTree)
+	if err != nil {
+		return err
+	}
+	var values []containers.Container
+	for i := int64(0); i < decoder.Count; i++ {
+		value, err := decoder.ReadElement(t.datatype)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+	if err := decoder.Close(); err != nil {
+		return err
+	}
+
+	t.replace(values)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the binary format written by Snapshot.
+func (t *Tree) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Snapshot(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder; similar to Restore, the tree must be created using one of the constructors
+// beforehand.
+func (t *Tree) GobDecode(data []byte) error {
+	return t.Restore(bytes.NewReader(data))
+}
@@ -0,0 +1,59 @@
+package bst
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/snapshot"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTree_Snapshot(t *testing.T) {
+	tree := NewInt()
+	for _, value := range []int{10, 5, 15, 1, 7, 20} {
+		tree.Insert(value)
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, tree.Snapshot(&buf))
+
+	restored := NewInt()
+	restored.Insert(100)
+	assert.NoError(t, restored.Restore(&buf))
+	assert.Equal(t, tree.ToSlice(), restored.ToSlice())
+	// The shape of the tree is restored
+	assert.Equal(t, tree.BreadthFirstSearch(), restored.BreadthFirstSearch())
+	assert.Equal(t, tree.Height, restored.Height)
+	assert.Equal(t, tree.Size(), restored.Size())
+}
+
+func TestTree_Restore_Corrupted(t *testing.T) {
+	tree := NewString()
+	tree.Insert("b")
+	tree.Insert("a")
+	var buf bytes.Buffer
+	assert.NoError(t, tree.Snapshot(&buf))
+	data := buf.Bytes()
+
+	restored := NewString()
+	restored.Insert("z")
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(data[:len(data)-4])), snapshot.ErrTruncated)
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-5] = 'c'
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(corrupted)), snapshot.ErrChecksumMismatch)
+	assert.Equal(t, []interface{}{"z"}, restored.ToSlice())
+
+	var zero Tree
+	assert.ErrorIs(t, zero.Restore(bytes.NewReader(data)), containers.ErrNoValueType)
+}
+
+func TestTree_Gob(t *testing.T) {
+	tree := NewFloat64()
+	tree.Insert(2.5)
+	tree.Insert(1.5)
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(tree))
+	decoded := NewFloat64()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	assert.Equal(t, []interface{}{1.5, 2.5}, decoded.ToSlice())
+}
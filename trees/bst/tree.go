@@ -25,6 +25,7 @@ SOFTWARE.
 package bst
 
 import (
+	"github.com/soheltarir/gollections"
 	"github.com/soheltarir/gollections/containers"
 	"github.com/soheltarir/gollections/queue"
 	"github.com/soheltarir/gollections/trees/binarytrees"
@@ -34,14 +35,34 @@ type Tree struct {
 	binarytrees.Tree
 	// internal attributes for type assertions
 	datatype containers.Container
+	// comparator orders the nodes of the tree, defaults to containers.Natural
+	comparator containers.Comparator
 }
 
 // Insert adds a new node at the leaf. Panics if type assertions fail
 // - Time Complexity: O(log(n))
 // - Space Complexity: O(log(n))
 func (t *Tree) Insert(value interface{}) {
-	newNode := &binarytrees.Node{Value: t.datatype.Validate(value)}
-	t.Root, t.Height = insertToTree(t.Root, newNode, 0)
+	t.insert(t.datatype.Validate(value))
+}
+
+// TryInsert is similar to Insert, but returns a *containers.TypeError instead of panicking if an invalid
+// type is provided.
+func (t *Tree) TryInsert(value interface{}) error {
+	element, err := containers.TryValidate(t.datatype, value)
+	if err != nil {
+		return err
+	}
+	t.insert(element)
+	return nil
+}
+
+func (t *Tree) insert(element containers.Container) {
+	newNode := &binarytrees.Node{Value: element}
+	t.Modify(func(root *binarytrees.Node, _ int, size int64) (*binarytrees.Node, int, int64) {
+		root, height := insertToTree(root, newNode, t.comparator, 0)
+		return root, height, size + 1
+	})
 }
 
 // BreadthFirstSearch traverses the tree across breadth. For more refer: https://en.wikipedia.org/wiki/Breadth-first_search
@@ -70,10 +91,31 @@ func (t *Tree) BreadthFirstSearch() []interface{} {
 	return nodes
 }
 
-// NewInt returns a binary search tree with nodes containing int data
-func NewInt() *Tree {
+// ToSlice returns the data of the tree's nodes in the sorted (in-order) order.
+func (t *Tree) ToSlice() []interface{} {
+	var values []interface{}
+	for value := range t.InOrder() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the tree in the sorted (in-order) order.
+func (t *Tree) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(t.ToSlice())
+}
+
+// newTree returns a binary search tree with nodes containing data of the datatype provided
+func newTree(datatype containers.Container) *Tree {
+	return NewFunc(datatype, containers.Natural)
+}
+
+// NewFunc returns a binary search tree with nodes containing data of the datatype provided, ordered by the
+// comparator instead of the Less method of the data.
+func NewFunc(datatype containers.Container, comparator containers.Comparator) *Tree {
 	tree := &Tree{
-		datatype: containers.IntContainer(0),
+		datatype:   datatype,
+		comparator: comparator,
 	}
 	// The below handling is required to achieve method overriding.
 	// Refer: https://stackoverflow.com/questions/38123911/golang-method-override
@@ -81,13 +123,92 @@ func NewInt() *Tree {
 	return tree
 }
 
+// NewInt returns a binary search tree with nodes containing int data
+func NewInt() *Tree {
+	return newTree(containers.IntContainer(0))
+}
+
 // NewString returns a binary search tree with nodes containing string data
 func NewString() *Tree {
-	tree := &Tree{
-		datatype: containers.StringContainer("A"),
-	}
-	// The below handling is required to achieve method overriding.
-	// Refer: https://stackoverflow.com/questions/38123911/golang-method-override
-	tree.TreeOperations = interface{}(tree).(binarytrees.TreeOperations)
-	return tree
+	return newTree(containers.StringContainer(""))
+}
+
+// NewFloat64 returns a binary search tree with nodes containing float64 data
+func NewFloat64() *Tree {
+	return newTree(containers.Float64Container(0))
+}
+
+// NewInt64 returns a binary search tree with nodes containing int64 data
+func NewInt64() *Tree {
+	return newTree(containers.Int64Container(0))
+}
+
+// NewUint64 returns a binary search tree with nodes containing uint64 data
+func NewUint64() *Tree {
+	return newTree(containers.Uint64Container(0))
+}
+
+// NewInt8 returns a binary search tree with nodes containing int8 data
+func NewInt8() *Tree {
+	return newTree(containers.Int8Container(0))
+}
+
+// NewInt16 returns a binary search tree with nodes containing int16 data
+func NewInt16() *Tree {
+	return newTree(containers.Int16Container(0))
+}
+
+// NewInt32 returns a binary search tree with nodes containing int32 data
+func NewInt32() *Tree {
+	return newTree(containers.Int32Container(0))
+}
+
+// NewUint returns a binary search tree with nodes containing uint data
+func NewUint() *Tree {
+	return newTree(containers.UintContainer(0))
+}
+
+// NewUint8 returns a binary search tree with nodes containing uint8 data
+func NewUint8() *Tree {
+	return newTree(containers.Uint8Container(0))
+}
+
+// NewUint16 returns a binary search tree with nodes containing uint16 data
+func NewUint16() *Tree {
+	return newTree(containers.Uint16Container(0))
+}
+
+// NewUint32 returns a binary search tree with nodes containing uint32 data
+func NewUint32() *Tree {
+	return newTree(containers.Uint32Container(0))
+}
+
+// NewFloat32 returns a binary search tree with nodes containing float32 data
+func NewFloat32() *Tree {
+	return newTree(containers.Float32Container(0))
+}
+
+// NewRune returns a binary search tree with nodes containing rune data
+func NewRune() *Tree {
+	return newTree(containers.RuneContainer(0))
+}
+
+// NewBool returns a binary search tree with nodes containing bool data
+func NewBool() *Tree {
+	return newTree(containers.BoolContainer(false))
+}
+
+// NewTime returns a binary search tree with nodes containing time.Time data
+func NewTime() *Tree {
+	return newTree(containers.TimeContainer{})
+}
+
+// NewDuration returns a binary search tree with nodes containing time.Duration data
+func NewDuration() *Tree {
+	return newTree(containers.DurationContainer(0))
+}
+
+// NewBytes returns a binary search tree with nodes containing []byte data
+func NewBytes() *Tree {
+	return newTree(containers.BytesContainer{})
 }
@@ -1,6 +1,7 @@
 package bst
 
 import (
+	"github.com/soheltarir/gollections/containers"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -43,3 +44,60 @@ func TestTree_BreadthFirstSearch(t *testing.T) {
 	actual := tree.BreadthFirstSearch()
 	assert.Equal(t, []interface{}{10, 1, 11, 3, 12, 4}, actual)
 }
+
+func TestTree_TryInsert(t *testing.T) {
+	tree := NewInt()
+	assert.NoError(t, tree.TryInsert(1))
+	assert.Error(t, tree.TryInsert("one"))
+	assert.Equal(t, 1, tree.Height)
+}
+
+func TestNewInt64(t *testing.T) {
+	tree := NewInt64()
+	tree.InsertMany(int64(2), int64(1), int64(3))
+	assert.Equal(t, []interface{}{int64(2), int64(1), int64(3)}, tree.BreadthFirstSearch())
+	assert.Panics(t, func() { tree.Insert(1) })
+}
+
+func TestNewFunc(t *testing.T) {
+	tree := NewFunc(containers.IntContainer(0), containers.Natural.Reverse())
+	tree.InsertMany(10, 1, 11)
+	assert.Equal(t, []interface{}{10, 11, 1}, tree.BreadthFirstSearch())
+}
+
+func TestTree_ToSlice(t *testing.T) {
+	tree := NewInt()
+	assert.Empty(t, tree.ToSlice())
+	tree.InsertMany(10, 1, 11, 3)
+	assert.Equal(t, []interface{}{1, 3, 10, 11}, tree.ToSlice())
+	assert.Equal(t, int64(4), tree.Size())
+	tree.Clear()
+	assert.True(t, tree.Empty())
+	assert.Zero(t, tree.Height)
+}
+
+func TestTree_InOrder(t *testing.T) {
+	tree := NewInt()
+	tree.InsertMany(10, 1, 11, 3)
+	var actual []interface{}
+	for v := range tree.InOrder() {
+		actual = append(actual, v)
+	}
+	assert.Equal(t, []interface{}{1, 3, 10, 11}, actual)
+}
+
+func TestTree_BreadthFirstTraverse_FailFast(t *testing.T) {
+	tree := NewInt()
+	tree.Insert(2)
+	tree.Insert(1)
+	it := tree.BreadthFirstTraverse()
+	tree.Insert(3)
+	_, err := it.TryNext()
+	assert.ErrorIs(t, err, containers.ErrConcurrentModification)
+
+	it = tree.BreadthFirstTraverse()
+	tree.Clear()
+	assert.PanicsWithValue(t, containers.ErrConcurrentModification, func() { it.Next() })
+	assert.True(t, tree.Empty())
+	assert.Equal(t, int64(0), tree.Size())
+}
@@ -2,7 +2,11 @@ package heaps
 
 import (
 	"container/heap"
+	"fmt"
+	"github.com/soheltarir/gollections"
 	"github.com/soheltarir/gollections/containers"
+	"iter"
+	"reflect"
 )
 
 type _heap struct {
@@ -10,45 +14,173 @@ type _heap struct {
 	data     []containers.Container
 	size     int
 	datatype containers.Container
+	// comparator orders the elements of the heap, defaults to containers.Natural
+	comparator containers.Comparator
 }
 
 func (h _heap) Len() int { return h.size }
 
 func (h *_heap) Swap(i, j int) {
 	h.data[i], h.data[j] = h.data[j], h.data[i]
+	setIndex(h.data[i], i)
+	setIndex(h.data[j], j)
 }
 
 func (h *_heap) Push(x interface{}) {
-	h.data = append(h.data, h.datatype.Validate(x))
+	// The values added by Insert are validated already, the others (e.g. heap.Push(h, 1)) are validated here
+	value, ok := x.(containers.Container)
+	if !ok || reflect.TypeOf(value) != reflect.TypeOf(h.datatype) {
+		value = h.datatype.Validate(x)
+	}
+	h.data = append(h.data, value)
+	setIndex(h.data[h.size], h.size)
 	h.size++
 }
 
 func (h *_heap) Pop() interface{} {
 	popped := h.data[h.size-1]
+	h.data[h.size-1] = nil
 	h.data = h.data[0 : h.size-1]
 	h.size--
+	setIndex(popped, -1)
 	return popped
 }
 
+// Indexed is implemented by the elements which keep track of their position in the heap's internal order, in order
+// to be used with Fix & Remove. SetIndex is called whenever the element moves, and with -1 once the element is
+// removed from the heap.
+type Indexed interface {
+	containers.Container
+	SetIndex(index int)
+}
+
+func setIndex(element containers.Container, index int) {
+	if indexed, ok := element.(Indexed); ok {
+		indexed.SetIndex(index)
+	}
+}
+
 // Insert allows both single & multiple elements to be added to the heap.
 // Time complexity for adding a single element is O(log(n)).
 // Time complexity for adding multiple elements is O(n)
+// Panics if an invalid type is provided.
 func (h *_heap) Insert(values ...interface{}) {
+	var correctedValues []containers.Container
+	for _, value := range values {
+		correctedValues = append(correctedValues, h.datatype.Validate(value))
+	}
+	h.insert(correctedValues)
+}
+
+// TryInsert is similar to Insert, but returns a *containers.TypeError instead of panicking if any of the values
+// is of an invalid type. The heap is left unmodified in case of an error.
+func (h *_heap) TryInsert(values ...interface{}) error {
+	var correctedValues []containers.Container
+	for _, value := range values {
+		corrected, err := containers.TryValidate(h.datatype, value)
+		if err != nil {
+			return err
+		}
+		correctedValues = append(correctedValues, corrected)
+	}
+	h.insert(correctedValues)
+	return nil
+}
+
+func (h *_heap) insert(values []containers.Container) {
 	if len(values) == 1 {
 		heap.Push(h, values[0])
 	} else {
-		var correctedValues []containers.Container
-		var total int
-		for _, value := range values {
-			correctedValues = append(correctedValues, h.datatype.Validate(value))
-			total++
+		for i, value := range values {
+			setIndex(value, h.size+i)
 		}
-		h.data = append(h.data, correctedValues...)
-		h.size = h.size + total
+		h.data = append(h.data, values...)
+		h.size = h.size + len(values)
 		heap.Init(h)
 	}
 }
 
+// Extract removes the element at the top of the heap and returns it. Unlike Peek, ToSlice & Drain, which return
+// the plain values (e.g. 5), Extract returns the containers.Container stored in the heap (e.g.
+// containers.IntContainer(5)), which can be converted using containers.CleanBasicType or the containers.ToX
+// functions.
 func (h *_heap) Extract() interface{} {
 	return heap.Pop(h)
 }
+
+// Remove removes the element at the index i of the heap's internal order and returns it, where the index of an
+// element is reported to it by SetIndex if it implements Indexed. Similar to Extract, the containers.Container stored
+// in the heap is returned. Time complexity is O(log(n)).
+// Panics if the index is out of range.
+func (h *_heap) Remove(i int) interface{} {
+	h.checkIndex(i)
+	return heap.Remove(h, i)
+}
+
+// Fix re-establishes the heap's order after the element at the index i of the heap's internal order has changed, e.g.,
+// an Indexed element whose priority has been updated in place. Time complexity is O(log(n)).
+// Panics if the index is out of range.
+func (h *_heap) Fix(i int) {
+	h.checkIndex(i)
+	heap.Fix(h, i)
+}
+
+func (h *_heap) checkIndex(i int) {
+	if i < 0 || i >= h.size {
+		panic(fmt.Sprintf("index %d out of range for heap of size %d", i, h.size))
+	}
+}
+
+// Peek returns the element at the top of the heap without removing it, or nil if the heap is empty.
+func (h *_heap) Peek() interface{} {
+	if h.size == 0 {
+		return nil
+	}
+	return containers.CleanBasicType(h.data[0])
+}
+
+// Size returns the number of elements in the heap
+func (h *_heap) Size() int64 {
+	return int64(h.size)
+}
+
+// Empty reports whether the heap has no elements
+func (h *_heap) Empty() bool {
+	return h.size == 0
+}
+
+// Clear removes all the elements from the heap
+func (h *_heap) Clear() {
+	for _, value := range h.data {
+		setIndex(value, -1)
+	}
+	h.data, h.size = nil, 0
+}
+
+// ToSlice returns the elements of the heap in the heap's internal order, i.e., the top of the heap is the first
+// element, but the remaining elements are not sorted.
+func (h *_heap) ToSlice() []interface{} {
+	values := make([]interface{}, 0, h.size)
+	for _, value := range h.data {
+		values = append(values, containers.CleanBasicType(value))
+	}
+	return values
+}
+
+// Iter returns a gollections.Iterator traversing a snapshot of the heap in the heap's internal order.
+func (h *_heap) Iter() gollections.Iterator {
+	return gollections.NewSliceIterator(h.ToSlice())
+}
+
+// Drain returns an iterator which extracts the elements of the heap in the heap's order, i.e., the top of the heap
+// first. The elements yielded are removed from the heap; breaking the loop early leaves the remaining elements in
+// the heap.
+func (h *_heap) Drain() iter.Seq[interface{}] {
+	return func(yield func(interface{}) bool) {
+		for h.size > 0 {
+			if !yield(containers.CleanBasicType(h.Extract().(containers.Container))) {
+				return
+			}
+		}
+	}
+}
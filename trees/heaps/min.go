@@ -31,16 +31,24 @@ import (
 
 /** Min Heap */
 
+// MinHeap is a binary heap whose top is the least element. Unlike the rest of the data-structures of gollections, the
+// operations on a heap aren't thread-safe; concurrent use must be synchronised by the caller.
 type MinHeap struct {
 	_heap
 }
 
 func (h MinHeap) Less(i, j int) bool {
-	return h.data[i].Less(h.data[j])
+	return h.comparator(h.data[i], h.data[j])
 }
 
 func NewMin(datatype containers.Container, elements ...interface{}) *MinHeap {
-	h := &MinHeap{_heap{datatype: datatype}}
+	return NewMinFunc(datatype, containers.Natural, elements...)
+}
+
+// NewMinFunc instantiates a min heap ordered by the comparator provided instead of the Less method of the elements,
+// i.e., the element ordered first by the comparator is at the top of the heap.
+func NewMinFunc(datatype containers.Container, comparator containers.Comparator, elements ...interface{}) *MinHeap {
+	h := &MinHeap{_heap{datatype: datatype, comparator: comparator}}
 	h.Interface = interface{}(h).(heap.Interface)
 	if len(elements) > 0 {
 		h.Insert(elements...)
@@ -52,14 +60,101 @@ func NewMinInt(elements ...interface{}) *MinHeap {
 	return NewMin(containers.IntContainer(0), elements...)
 }
 
+// NewMinString instantiates a min heap of string elements
+func NewMinString(elements ...interface{}) *MinHeap {
+	return NewMin(containers.StringContainer(""), elements...)
+}
+
+// NewMinFloat64 instantiates a min heap of float64 elements
+func NewMinFloat64(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Float64Container(0), elements...)
+}
+
+// NewMinInt64 instantiates a min heap of int64 elements
+func NewMinInt64(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Int64Container(0), elements...)
+}
+
+// NewMinUint64 instantiates a min heap of uint64 elements
+func NewMinUint64(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Uint64Container(0), elements...)
+}
+
+// NewMinInt8 instantiates a min heap of int8 elements
+func NewMinInt8(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Int8Container(0), elements...)
+}
+
+// NewMinInt16 instantiates a min heap of int16 elements
+func NewMinInt16(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Int16Container(0), elements...)
+}
+
+// NewMinInt32 instantiates a min heap of int32 elements
+func NewMinInt32(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Int32Container(0), elements...)
+}
+
+// NewMinUint instantiates a min heap of uint elements
+func NewMinUint(elements ...interface{}) *MinHeap {
+	return NewMin(containers.UintContainer(0), elements...)
+}
+
+// NewMinUint8 instantiates a min heap of uint8 elements
+func NewMinUint8(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Uint8Container(0), elements...)
+}
+
+// NewMinUint16 instantiates a min heap of uint16 elements
+func NewMinUint16(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Uint16Container(0), elements...)
+}
+
+// NewMinUint32 instantiates a min heap of uint32 elements
+func NewMinUint32(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Uint32Container(0), elements...)
+}
+
+// NewMinFloat32 instantiates a min heap of float32 elements
+func NewMinFloat32(elements ...interface{}) *MinHeap {
+	return NewMin(containers.Float32Container(0), elements...)
+}
+
+// NewMinRune instantiates a min heap of rune elements
+func NewMinRune(elements ...interface{}) *MinHeap {
+	return NewMin(containers.RuneContainer(0), elements...)
+}
+
+// NewMinBool instantiates a min heap of bool elements
+func NewMinBool(elements ...interface{}) *MinHeap {
+	return NewMin(containers.BoolContainer(false), elements...)
+}
+
+// NewMinTime instantiates a min heap of time.Time elements
+func NewMinTime(elements ...interface{}) *MinHeap {
+	return NewMin(containers.TimeContainer{}, elements...)
+}
+
+// NewMinDuration instantiates a min heap of time.Duration elements
+func NewMinDuration(elements ...interface{}) *MinHeap {
+	return NewMin(containers.DurationContainer(0), elements...)
+}
+
+// NewMinBytes instantiates a min heap of []byte elements
+func NewMinBytes(elements ...interface{}) *MinHeap {
+	return NewMin(containers.BytesContainer{}, elements...)
+}
+
 /** Max Heap */
 
+// MaxHeap is a binary heap whose top is the greatest element. Unlike the rest of the data-structures of gollections,
+// the operations on a heap aren't thread-safe; concurrent use must be synchronised by the caller.
 type MaxHeap struct {
 	_heap
 }
 
 func (h MaxHeap) Less(i, j int) bool {
-	return h.data[j].Less(h.data[i])
+	return h.comparator(h.data[j], h.data[i])
 }
 
 // NLargest returns a list with n largest elements
@@ -71,7 +166,13 @@ func (h MaxHeap) NLargest(n int) []containers.Container {
 }
 
 func NewMax(datatype containers.Container, elements ...interface{}) *MaxHeap {
-	h := &MaxHeap{_heap{datatype: datatype}}
+	return NewMaxFunc(datatype, containers.Natural, elements...)
+}
+
+// NewMaxFunc instantiates a max heap ordered by the comparator provided instead of the Less method of the elements,
+// i.e., the element ordered last by the comparator is at the top of the heap.
+func NewMaxFunc(datatype containers.Container, comparator containers.Comparator, elements ...interface{}) *MaxHeap {
+	h := &MaxHeap{_heap{datatype: datatype, comparator: comparator}}
 	h.Interface = interface{}(h).(heap.Interface)
 	if len(elements) > 0 {
 		h.Insert(elements...)
@@ -82,3 +183,88 @@ func NewMax(datatype containers.Container, elements ...interface{}) *MaxHeap {
 func NewMaxInt(elements ...interface{}) *MaxHeap {
 	return NewMax(containers.IntContainer(0), elements...)
 }
+
+// NewMaxString instantiates a max heap of string elements
+func NewMaxString(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.StringContainer(""), elements...)
+}
+
+// NewMaxFloat64 instantiates a max heap of float64 elements
+func NewMaxFloat64(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Float64Container(0), elements...)
+}
+
+// NewMaxInt64 instantiates a max heap of int64 elements
+func NewMaxInt64(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Int64Container(0), elements...)
+}
+
+// NewMaxUint64 instantiates a max heap of uint64 elements
+func NewMaxUint64(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Uint64Container(0), elements...)
+}
+
+// NewMaxInt8 instantiates a max heap of int8 elements
+func NewMaxInt8(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Int8Container(0), elements...)
+}
+
+// NewMaxInt16 instantiates a max heap of int16 elements
+func NewMaxInt16(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Int16Container(0), elements...)
+}
+
+// NewMaxInt32 instantiates a max heap of int32 elements
+func NewMaxInt32(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Int32Container(0), elements...)
+}
+
+// NewMaxUint instantiates a max heap of uint elements
+func NewMaxUint(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.UintContainer(0), elements...)
+}
+
+// NewMaxUint8 instantiates a max heap of uint8 elements
+func NewMaxUint8(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Uint8Container(0), elements...)
+}
+
+// NewMaxUint16 instantiates a max heap of uint16 elements
+func NewMaxUint16(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Uint16Container(0), elements...)
+}
+
+// NewMaxUint32 instantiates a max heap of uint32 elements
+func NewMaxUint32(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Uint32Container(0), elements...)
+}
+
+// NewMaxFloat32 instantiates a max heap of float32 elements
+func NewMaxFloat32(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.Float32Container(0), elements...)
+}
+
+// NewMaxRune instantiates a max heap of rune elements
+func NewMaxRune(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.RuneContainer(0), elements...)
+}
+
+// NewMaxBool instantiates a max heap of bool elements
+func NewMaxBool(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.BoolContainer(false), elements...)
+}
+
+// NewMaxTime instantiates a max heap of time.Time elements
+func NewMaxTime(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.TimeContainer{}, elements...)
+}
+
+// NewMaxDuration instantiates a max heap of time.Duration elements
+func NewMaxDuration(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.DurationContainer(0), elements...)
+}
+
+// NewMaxBytes instantiates a max heap of []byte elements
+func NewMaxBytes(elements ...interface{}) *MaxHeap {
+	return NewMax(containers.BytesContainer{}, elements...)
+}
@@ -1,6 +1,7 @@
 package heaps
 
 import (
+	"container/heap"
 	"github.com/soheltarir/gollections/containers"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -28,5 +29,136 @@ func Test_heap_Insert(t *testing.T) {
 
 func Test_heap_Extract(t *testing.T) {
 	heap := NewMinInt(10, 20, 30, 5)
-	assert.Equal(t, 5, containers.ToInt(heap.Extract()))
+	assert.Equal(t, 5, heap.Peek())
+	assert.Equal(t, containers.IntContainer(5), heap.Extract())
+}
+
+func Test_heap_Peek(t *testing.T) {
+	heap := NewMaxInt()
+	assert.Nil(t, heap.Peek())
+	heap.Insert(10, 30, 20)
+	assert.Equal(t, 30, heap.Peek())
+	assert.Equal(t, int64(3), heap.Size())
+}
+
+func Test_heap_TryInsert(t *testing.T) {
+	heap := NewMinInt()
+	assert.NoError(t, heap.TryInsert(10, 5))
+	assert.Error(t, heap.TryInsert(1, "a"))
+	assert.Equal(t, 2, heap.Len())
+	assert.Equal(t, 5, containers.ToInt(heap.data[0]))
+}
+
+func TestNewMinFloat64(t *testing.T) {
+	heap := NewMinFloat64(2.5, 1.5, 3.5)
+	assert.Equal(t, 1.5, containers.ToFloat64(heap.Extract()))
+}
+
+func TestNewMaxString(t *testing.T) {
+	heap := NewMaxString("a", "c", "b")
+	assert.Equal(t, "c", containers.ToString(heap.Extract()))
+}
+
+func TestNewMinFunc(t *testing.T) {
+	byLength := containers.ByKey(func(c containers.Container) interface{} { return len(c.(containers.StringContainer)) })
+	heap := NewMinFunc(containers.StringContainer(""), byLength, "ccc", "a", "bb")
+	assert.Equal(t, "a", containers.ToString(heap.Extract()))
+	assert.Equal(t, "bb", containers.ToString(heap.Extract()))
+}
+
+func TestNewMaxFunc(t *testing.T) {
+	heap := NewMaxFunc(containers.IntContainer(0), containers.Natural.Reverse(), 3, 1, 2)
+	// The reversed comparator makes the max heap behave as a min heap
+	assert.Equal(t, 1, containers.ToInt(heap.Extract()))
+}
+
+func Test_heap_ToSlice(t *testing.T) {
+	heap := NewMinInt(3, 1, 2)
+	assert.Equal(t, int64(3), heap.Size())
+	assert.Equal(t, 1, heap.ToSlice()[0])
+	heap.Clear()
+	assert.True(t, heap.Empty())
+}
+
+func Test_heap_Drain(t *testing.T) {
+	heap := NewMinInt(5, 3, 4, 1, 2)
+	var actual []interface{}
+	for v := range heap.Drain() {
+		actual = append(actual, v)
+		if v == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []interface{}{1, 2, 3}, actual)
+	// The remaining elements are left in the heap
+	assert.Equal(t, int64(2), heap.Size())
+	assert.Equal(t, 4, containers.ToInt(heap.Extract()))
+}
+
+// job is an Indexed element ordered by its priority
+type job struct {
+	priority int
+	index    int
+}
+
+func (j *job) Key() interface{}                            { return j }
+func (j *job) Less(other containers.Container) bool        { return j.priority < other.(*job).priority }
+func (j *job) Validate(x interface{}) containers.Container { return x.(*job) }
+func (j *job) SetIndex(index int)                          { j.index = index }
+
+func Test_heap_Remove(t *testing.T) {
+	heap := NewMinInt(5, 1, 4, 2, 3)
+	for i, value := range heap.ToSlice() {
+		if value == 4 {
+			assert.Equal(t, 4, containers.ToInt(heap.Remove(i)))
+		}
+	}
+	assert.Equal(t, int64(4), heap.Size())
+	var values []interface{}
+	for value := range heap.Drain() {
+		values = append(values, value)
+	}
+	assert.Equal(t, []interface{}{1, 2, 3, 5}, values)
+
+	assert.Panics(t, func() { heap.Remove(0) })
+	assert.Panics(t, func() { NewMinInt(1).Remove(-1) })
+}
+
+func Test_heap_Indexed(t *testing.T) {
+	jobs := []*job{{priority: 5}, {priority: 1}, {priority: 4}, {priority: 2}}
+	heap := NewMax(&job{}, jobs[0], jobs[1], jobs[2])
+	heap.Insert(jobs[3])
+	for _, j := range jobs {
+		assert.Same(t, j, heap.data[j.index])
+	}
+
+	// Move the least element to the top
+	jobs[1].priority = 10
+	heap.Fix(jobs[1].index)
+	assert.Same(t, jobs[1], heap.Peek())
+
+	removed := jobs[0]
+	assert.Same(t, removed, heap.Remove(removed.index))
+	assert.Equal(t, -1, removed.index)
+	for _, j := range jobs[1:] {
+		assert.Same(t, j, heap.data[j.index])
+	}
+
+	extracted := heap.Extract().(*job)
+	assert.Same(t, jobs[1], extracted)
+	assert.Equal(t, -1, extracted.index)
+
+	heap.Clear()
+	assert.Equal(t, -1, jobs[2].index)
+	assert.Equal(t, -1, jobs[3].index)
+}
+
+func Test_heap_Push(t *testing.T) {
+	h := NewMinInt(3, 1)
+	heap.Push(h, 2)
+	heap.Push(h, containers.IntContainer(0))
+	assert.Equal(t, int64(4), h.Size())
+	assert.IsType(t, containers.IntContainer(0), h.data[h.size-1])
+	assert.Equal(t, 0, h.Peek())
+	assert.Panics(t, func() { heap.Push(h, "a") })
 }
@@ -0,0 +1,54 @@
+package heaps
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/soheltarir/gollections/containers"
+	"github.com/soheltarir/gollections/snapshot"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHeap_Snapshot(t *testing.T) {
+	h := NewMinInt(5, 3, 8, 1)
+	var buf bytes.Buffer
+	assert.NoError(t, h.Snapshot(&buf))
+	data := buf.Bytes()
+
+	restored := NewMinInt(100)
+	assert.NoError(t, restored.Restore(bytes.NewReader(data)))
+	assert.Equal(t, int64(4), restored.Size())
+	var actual []interface{}
+	for value := range restored.Drain() {
+		actual = append(actual, value)
+	}
+	assert.Equal(t, []interface{}{1, 3, 5, 8}, actual)
+
+	// The elements are re-ordered by the restoring heap
+	maxHeap := NewMaxInt()
+	assert.NoError(t, maxHeap.Restore(bytes.NewReader(data)))
+	assert.Equal(t, 8, maxHeap.Extract().(containers.Container).Key())
+}
+
+func TestHeap_Restore_Truncated(t *testing.T) {
+	h := NewMaxString("a", "b", "c")
+	var buf bytes.Buffer
+	assert.NoError(t, h.Snapshot(&buf))
+	data := buf.Bytes()
+
+	restored := NewMaxString("z")
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(data[:len(data)-5])), snapshot.ErrTruncated)
+	assert.Equal(t, []interface{}{"z"}, restored.ToSlice())
+
+	var zero MinHeap
+	assert.ErrorIs(t, zero.Restore(bytes.NewReader(data)), containers.ErrNoValueType)
+}
+
+func TestHeap_Gob(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(NewMinFloat64(2.5, 1.5)))
+	decoded := NewMinFloat64()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(decoded))
+	assert.Equal(t, int64(2), decoded.Size())
+	assert.Equal(t, 1.5, containers.ToFloat64(decoded.Extract()))
+}
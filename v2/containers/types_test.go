@@ -0,0 +1,27 @@
+package containers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type user struct {
+	ID   string
+	Name string
+}
+
+func TestLess(t *testing.T) {
+	assert.True(t, Less(1, 2))
+	assert.False(t, Less(2, 1))
+	assert.True(t, Less("app", "apple"))
+}
+
+func TestGreater(t *testing.T) {
+	assert.True(t, Greater(2.5, 1.0))
+	assert.False(t, Greater("a", "b"))
+}
+
+func TestLessFunc(t *testing.T) {
+	var byName LessFunc[user] = func(a, b user) bool { return a.Name < b.Name }
+	assert.True(t, byName(user{ID: "2", Name: "A"}, user{ID: "1", Name: "B"}))
+}
@@ -0,0 +1,38 @@
+package lists_test
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/v2/lists"
+)
+
+func Example() {
+	// Instantiates an int list, the element type is checked at compile time
+	list := lists.New[int]()
+
+	// Insert elements. list.Begin() points to head of the list
+	list.Insert(list.Begin(), 1, 2, 3)
+	fmt.Println(list.Display())
+
+	// Push at the back of the list
+	list.PushBack(4)
+	// Add new element at the front of the list
+	list.PushFront(5)
+	fmt.Println(list.Display())
+
+	// Pops return the value along with whether the list had an element
+	front, _ := list.PopFront()
+	back, _ := list.PopBack()
+	fmt.Println(front, back)
+
+	// Iterate through the list in forward direction
+	for it := list.Begin(); it != list.End(); it = it.Next() {
+		fmt.Printf("%d->", it.Value())
+	}
+	fmt.Printf("\n")
+
+	// Output:
+	// 1 <-> 2 <-> 3
+	// 5 <-> 1 <-> 2 <-> 3 <-> 4
+	// 5 4
+	// 1->2->3->
+}
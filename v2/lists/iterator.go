@@ -0,0 +1,98 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package lists
+
+import (
+	"fmt"
+)
+
+type direction uint
+
+const (
+	forwardDirection direction = iota
+	backwardDirection
+)
+
+// Iterator is a stateful iterator for traversing a linked list.
+// Please note, that iteration over a list is not a thread-safe operation, and if parallel write operations are
+// being performed on the list, the traversal can provide stale and outdated data.
+type Iterator[T any] struct {
+	list        *List[T]
+	currentNode *Node[T]
+	direction   direction
+	index       int64
+}
+
+// Next returns the iterator to the next/previous element in the list based on the traversal direction. Panics if
+// the iterator reaches out of bounds
+// Please note, iteration over a list is not a thread-safe operation.
+func (it *Iterator[T]) Next() *Iterator[T] {
+	if it.currentNode == nil {
+		panic("iterator crossed list's bounds")
+	}
+	nextIt := &Iterator[T]{list: it.list, direction: it.direction}
+	switch it.direction {
+	case forwardDirection:
+		if it.currentNode.next == nil {
+			return it.list.End()
+		}
+		nextIt.currentNode, nextIt.index = it.currentNode.next, it.index+1
+	case backwardDirection:
+		if it.currentNode.previous == nil {
+			return it.list.REnd()
+		}
+		nextIt.currentNode, nextIt.index = it.currentNode.previous, it.index-1
+	}
+	return nextIt
+}
+
+// IsEqual reports whether both the iterators point to the same element.
+func (it *Iterator[T]) IsEqual(it2 *Iterator[T]) bool {
+	return it.currentNode == it2.currentNode
+}
+
+// Advance moves the iterator forward by the no. of the steps provided
+func (it *Iterator[T]) Advance(steps int) (*Iterator[T], error) {
+	if steps <= 0 {
+		return it, fmt.Errorf("step size should be greater than zero")
+	}
+	newItr := it
+	for steps > 0 {
+		newItr = newItr.Next()
+		steps--
+	}
+	*it = *newItr
+	return it, nil
+}
+
+// Value returns the current element's value
+func (it *Iterator[T]) Value() T {
+	return it.currentNode.Value
+}
+
+// Index returns the current element's index in the list
+func (it *Iterator[T]) Index() int64 {
+	return it.index
+}
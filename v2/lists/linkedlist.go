@@ -0,0 +1,329 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package lists exposes adaptors for doubly-linked list data-structure.
+//
+// Lists are sequence containers that allow constant time insert and erase operations anywhere within the sequence,
+// and iteration in both directions.
+// List containers are implemented as doubly-linked lists; Doubly linked lists can store each of the elements they
+// contain in different and unrelated storage locations. The ordering is kept internally by the association to each
+// element of a link to the element preceding it and a link to the element following it.
+package lists
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Node represents an element in a Linked List
+type Node[T any] struct {
+	Value    T
+	next     *Node[T]
+	previous *Node[T]
+}
+
+// List is a sequence container that allow constant time insert and erase operations anywhere within the sequence,
+// and iteration in both directions.
+type List[T any] struct {
+	head *Node[T]
+	tail *Node[T]
+	size int64
+	// end & rEnd are the past-the-end iterators of the list in forward & backward directions respectively
+	end  *Iterator[T]
+	rEnd *Iterator[T]
+	mu   sync.RWMutex
+}
+
+/** Element Access **/
+
+// Front returns the value of the first element of the linked list.
+// The boolean is false if the list is empty.
+func (ll *List[T]) Front() (T, bool) {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	if ll.head == nil {
+		var zero T
+		return zero, false
+	}
+	return ll.head.Value, true
+}
+
+// Back returns the value of the last element of the linked list.
+// The boolean is false if the list is empty.
+func (ll *List[T]) Back() (T, bool) {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	if ll.tail == nil {
+		var zero T
+		return zero, false
+	}
+	return ll.tail.Value, true
+}
+
+/** Iterators */
+
+// Begin returns an iterator pointing to the first element in the list container.
+func (ll *List[T]) Begin() *Iterator[T] {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	if ll.size == 0 {
+		return ll.End()
+	}
+	return &Iterator[T]{list: ll, currentNode: ll.head, direction: forwardDirection, index: 0}
+}
+
+// End Returns an iterator referring to the past-the-end element in the list container.
+func (ll *List[T]) End() *Iterator[T] {
+	return ll.end
+}
+
+// RBegin returns a reverse iterator pointing to the last element in the container (i.e., its reverse beginning).
+// Reverse iterators iterate backwards: increasing them moves them towards the beginning of the container.
+func (ll *List[T]) RBegin() *Iterator[T] {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	if ll.size == 0 {
+		return ll.REnd()
+	}
+	return &Iterator[T]{list: ll, currentNode: ll.tail, direction: backwardDirection, index: ll.size - 1}
+}
+
+// REnd returns a reverse iterator pointing to the theoretical element preceding the first element
+// in the list container
+func (ll *List[T]) REnd() *Iterator[T] {
+	return ll.rEnd
+}
+
+/** Modifiers */
+
+// PushFront inserts a new element at the beginning of the list, right before its current first element.
+// This effectively increases the container size by one.
+func (ll *List[T]) PushFront(val T) {
+	node := &Node[T]{Value: val}
+
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	if ll.size == 0 {
+		ll.head, ll.tail = node, node
+	} else {
+		node.next = ll.head
+		ll.head.previous = node
+		ll.head = node
+	}
+	ll.size++
+}
+
+// PushBack adds a new element at the end of the list container, after its current last element.
+// This effectively increases the container size by one.
+func (ll *List[T]) PushBack(val T) {
+	node := &Node[T]{Value: val}
+
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	if ll.size == 0 {
+		ll.head, ll.tail = node, node
+	} else {
+		node.previous = ll.tail
+		ll.tail.next = node
+		ll.tail = node
+	}
+	ll.size++
+}
+
+// PopFront deletes the first element of the list and returns it's value.
+// The boolean is false if the list is empty.
+func (ll *List[T]) PopFront() (T, bool) {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	if ll.size == 0 {
+		var zero T
+		return zero, false
+	}
+	head := ll.head
+	ll.unlink(head)
+	return head.Value, true
+}
+
+// PopBack deletes the last element of the list and returns it's value.
+// The boolean is false if the list is empty.
+func (ll *List[T]) PopBack() (T, bool) {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	if ll.size == 0 {
+		var zero T
+		return zero, false
+	}
+	tail := ll.tail
+	ll.unlink(tail)
+	return tail.Value, true
+}
+
+// Insert extends the list by inserting new elements before the element at the specified position.
+// This effectively increases the list size by the amount of elements inserted.
+// Inserting at End() appends the elements at the back of the list.
+func (ll *List[T]) Insert(it *Iterator[T], elements ...T) {
+	if len(elements) == 0 {
+		return
+	}
+	// Chain the new elements before acquiring the lock
+	tmpHead := &Node[T]{Value: elements[0]}
+	tmpTail := tmpHead
+	for _, element := range elements[1:] {
+		node := &Node[T]{Value: element, previous: tmpTail}
+		tmpTail.next = node
+		tmpTail = node
+	}
+
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	switch {
+	case ll.size == 0:
+		ll.head, ll.tail = tmpHead, tmpTail
+	case it.currentNode == nil:
+		// Past-the-end iterator, i.e., append at the back of the list
+		ll.tail.next = tmpHead
+		tmpHead.previous = ll.tail
+		ll.tail = tmpTail
+	default:
+		prev := it.currentNode.previous
+		if prev != nil {
+			prev.next = tmpHead
+			tmpHead.previous = prev
+		} else {
+			// This is the case wherein the elements are being pushed before the head of the list
+			ll.head = tmpHead
+		}
+		it.currentNode.previous = tmpTail
+		tmpTail.next = it.currentNode
+	}
+	ll.size += int64(len(elements))
+}
+
+// Clear Removes all elements from the list container (which are destroyed), and leaving the list with a size of 0.
+func (ll *List[T]) Clear() {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	ll.head, ll.tail, ll.size = nil, nil, 0
+}
+
+// unlink detaches the node from the list. The caller must hold the write lock.
+func (ll *List[T]) unlink(node *Node[T]) {
+	prev, next := node.previous, node.next
+	if prev != nil {
+		prev.next = next
+	} else {
+		ll.head = next
+	}
+	if next != nil {
+		next.previous = prev
+	} else {
+		ll.tail = prev
+	}
+	node.previous, node.next = nil, nil
+	ll.size--
+}
+
+// Erase removes from the list container either a single element or a range of elements ([first,last)).
+// Note: The bounds are including the first iterator & excluding the last iterator
+func (ll *List[T]) Erase(iterators ...*Iterator[T]) error {
+	if len(iterators) > 2 || len(iterators) == 0 {
+		return fmt.Errorf("please provide a single iterator or the iterator bounds (i.e., only two iterators)")
+	}
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+
+	if len(iterators) == 1 {
+		if iterators[0].currentNode == nil {
+			return fmt.Errorf("cannot erase the past-the-end iterator")
+		}
+		ll.unlink(iterators[0].currentNode)
+		return nil
+	}
+
+	first, last := iterators[0].currentNode, iterators[1].currentNode
+	for node := first; node != nil && node != last; {
+		next := node.next
+		if iterators[0].direction == backwardDirection {
+			next = node.previous
+		}
+		ll.unlink(node)
+		node = next
+	}
+	return nil
+}
+
+/** Capacity Functions **/
+
+// Size returns the length of the linked list
+func (ll *List[T]) Size() int64 {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	return ll.size
+}
+
+// Empty returns whether the list container is empty (i.e. whether its size is 0).
+func (ll *List[T]) Empty() bool {
+	return ll.Size() == 0
+}
+
+/** Display Functions **/
+
+// Display returns a string representation of the linked list.
+func (ll *List[T]) Display() string {
+	ll.mu.RLock()
+	defer ll.mu.RUnlock()
+
+	var b strings.Builder
+	for node := ll.head; node != nil; node = node.next {
+		if node != ll.tail {
+			_, _ = fmt.Fprintf(&b, "%v <-> ", node.Value)
+		} else {
+			_, _ = fmt.Fprintf(&b, "%v", node.Value)
+		}
+	}
+	return b.String()
+}
+
+/** Constructors **/
+
+// New constructs a linked list containing the values provided (order is preserved).
+func New[T any](values ...T) *List[T] {
+	ll := &List[T]{}
+	ll.end = &Iterator[T]{list: ll, direction: forwardDirection}
+	ll.rEnd = &Iterator[T]{list: ll, direction: backwardDirection}
+	ll.Insert(ll.End(), values...)
+	return ll
+}
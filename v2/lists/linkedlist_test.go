@@ -0,0 +1,151 @@
+package lists
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func toSlice[T any](ll *List[T]) []T {
+	var result []T
+	for it := ll.Begin(); it != ll.End(); it = it.Next() {
+		result = append(result, it.Value())
+	}
+	return result
+}
+
+func TestNew(t *testing.T) {
+	ll := New(1, 2, 3)
+	assert.Equal(t, int64(3), ll.Size())
+	assert.Equal(t, []int{1, 2, 3}, toSlice(ll))
+}
+
+func TestList_PushFront(t *testing.T) {
+	ll := New[int]()
+	ll.PushFront(1)
+	ll.PushFront(2)
+	front, ok := ll.Front()
+	assert.True(t, ok)
+	assert.Equal(t, 2, front)
+}
+
+func TestList_PushBack(t *testing.T) {
+	ll := New[string]()
+	ll.PushBack("a")
+	ll.PushFront("b")
+	back, ok := ll.Back()
+	assert.True(t, ok)
+	assert.Equal(t, "a", back)
+}
+
+func TestList_FrontBackEmpty(t *testing.T) {
+	ll := New[int]()
+	_, ok := ll.Front()
+	assert.False(t, ok)
+	_, ok = ll.Back()
+	assert.False(t, ok)
+}
+
+func TestListBackwardIteration(t *testing.T) {
+	var result []int
+	ll := New[int]()
+	for it := ll.RBegin(); it != ll.REnd(); it = it.Next() {
+		result = append(result, it.Value())
+	}
+	assert.Empty(t, result)
+
+	ll.PushBack(1)
+	ll.PushBack(2)
+	ll.PushFront(3)
+	var lastIndex int64
+	for it := ll.RBegin(); it != ll.REnd(); it = it.Next() {
+		result = append(result, it.Value())
+		lastIndex = it.Index()
+	}
+	assert.Equal(t, []int{2, 1, 3}, result)
+	assert.Equal(t, int64(0), lastIndex)
+}
+
+func TestList_PopFront(t *testing.T) {
+	ll := New(1, 2)
+	value, ok := ll.PopFront()
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	value, _ = ll.PopFront()
+	assert.Equal(t, 2, value)
+	_, ok = ll.PopFront()
+	assert.False(t, ok)
+	assert.True(t, ll.Empty())
+}
+
+func TestList_PopBack(t *testing.T) {
+	ll := New(1, 2)
+	value, ok := ll.PopBack()
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	// Popping the only element should leave the list empty
+	value, _ = ll.PopBack()
+	assert.Equal(t, 1, value)
+	_, ok = ll.PopBack()
+	assert.False(t, ok)
+	_, ok = ll.Front()
+	assert.False(t, ok)
+}
+
+func TestList_Insert(t *testing.T) {
+	ll := New[int]()
+	ll.Insert(ll.Begin(), 1, 2, 3)
+	it := ll.Begin()
+	_, _ = it.Advance(1)
+	ll.Insert(it, 4, 5)
+	assert.Equal(t, []int{1, 4, 5, 2, 3}, toSlice(ll))
+
+	ll.Insert(ll.End(), 6)
+	assert.Equal(t, []int{1, 4, 5, 2, 3, 6}, toSlice(ll))
+}
+
+func TestList_Erase(t *testing.T) {
+	ll := New(1, 2, 3)
+	it := ll.Begin()
+	_, _ = it.Advance(1)
+	assert.NoError(t, ll.Erase(it))
+	assert.Equal(t, []int{1, 3}, toSlice(ll))
+
+	ll.Insert(ll.Begin(), 4, 5, 6, 7)
+	it1, it2 := ll.Begin(), ll.Begin()
+	_, _ = it2.Advance(4)
+	assert.NoError(t, ll.Erase(it1, it2))
+	assert.Equal(t, []int{1, 3}, toSlice(ll))
+
+	assert.Error(t, ll.Erase(it, it1, it2))
+	assert.Error(t, ll.Erase(ll.End()))
+
+	assert.NoError(t, ll.Erase(ll.RBegin()))
+	assert.Equal(t, []int{1}, toSlice(ll))
+}
+
+func TestList_Clear(t *testing.T) {
+	ll := New("a", "b")
+	ll.Clear()
+	assert.True(t, ll.Empty())
+}
+
+func TestIterator_Next(t *testing.T) {
+	ll := New(1, 2)
+	it := ll.Begin().Next()
+	assert.Equal(t, 2, it.Value())
+	assert.Panics(t, func() {
+		it = it.Next()
+		it = it.Next()
+	})
+}
+
+func TestIterator_Advance(t *testing.T) {
+	ll := New(1, 2, 3)
+	_, err := ll.Begin().Advance(-1)
+	assert.Error(t, err)
+}
+
+func TestList_Display(t *testing.T) {
+	ll := New(1, 2, 3, 4, 5)
+	assert.Equal(t, "1 <-> 2 <-> 3 <-> 4 <-> 5", ll.Display())
+}
@@ -0,0 +1,135 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package counter exposes a map container for counting hashable objects
+package counter
+
+import (
+	"github.com/soheltarir/gollections/v2/trees/heaps"
+	"sync"
+)
+
+// Counter is map for counting hashable items. Sometimes called a bag or multiset.
+// Elements are stored as map keys and their counters are stored as map values
+type Counter[K comparable] struct {
+	counts map[K]int
+	mu     sync.RWMutex
+}
+
+// Size returns the number of distinct elements in the counter
+func (c *Counter[K]) Size() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.counts)
+}
+
+// Add increments the counter for the element provided
+func (c *Counter[K]) Add(element K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts[element]++
+}
+
+// AddMany updates the counts for the arguments provided
+func (c *Counter[K]) AddMany(elements ...K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, element := range elements {
+		c.counts[element]++
+	}
+}
+
+// Subtract decrements the counter for the element provided. Counts can be reduced below zero.
+func (c *Counter[K]) Subtract(element K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts[element]--
+}
+
+// Delete removes an item from the counter map completely. If there is no such element, delete is a no-op.
+func (c *Counter[K]) Delete(element K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.counts, element)
+}
+
+// Get returns the current counter for the object provided. Returns zero if the key is not found in the counter
+func (c *Counter[K]) Get(element K) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.counts[element]
+}
+
+// Range calls callback sequentially for each key & value (the counter) in the Counter object.
+// The callback must not modify the counter.
+func (c *Counter[K]) Range(callback func(key K, value int)) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for key, count := range c.counts {
+		callback(key, count)
+	}
+}
+
+// MostCommon lists the elements having the n highest counts. Elements sharing a count are all included.
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+func (c *Counter[K]) MostCommon(n int) map[K]int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	reverseCounterMap := make(map[int][]K)
+	var counts []int
+	for key, count := range c.counts {
+		if _, found := reverseCounterMap[count]; !found {
+			counts = append(counts, count)
+		}
+		reverseCounterMap[count] = append(reverseCounterMap[count], key)
+	}
+	heap := heaps.NewMax(counts...)
+	result := make(map[K]int)
+	for i := 0; i < n; i++ {
+		count, ok := heap.Extract()
+		if !ok {
+			break
+		}
+		for _, key := range reverseCounterMap[count] {
+			result[key] = count
+		}
+	}
+	return result
+}
+
+// New instantiates a new counter object with the elements provided
+func New[K comparable](elements ...K) *Counter[K] {
+	counter := &Counter[K]{counts: make(map[K]int)}
+	counter.AddMany(elements...)
+	return counter
+}
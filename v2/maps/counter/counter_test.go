@@ -0,0 +1,45 @@
+package counter
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCounter_Add(t *testing.T) {
+	counter := New[string]()
+	counter.Add("a")
+	counter.Add("a")
+	assert.Equal(t, 2, counter.Get("a"))
+	assert.Equal(t, 1, counter.Size())
+}
+
+func TestCounter_Subtract(t *testing.T) {
+	counter := New("a", "a")
+	counter.Subtract("a")
+	assert.Equal(t, 1, counter.Get("a"))
+	counter.Subtract("c")
+	assert.Equal(t, -1, counter.Get("c"))
+}
+
+func TestCounter_MostCommon(t *testing.T) {
+	counter := New("a", "a", "b")
+	assert.Equal(t, map[string]int{"a": 2}, counter.MostCommon(1))
+	counter.Add("b")
+	assert.Equal(t, map[string]int{"a": 2, "b": 2}, counter.MostCommon(2))
+	counter.Add("c")
+	assert.Equal(t, map[string]int{"a": 2, "b": 2, "c": 1}, counter.MostCommon(5))
+}
+
+func TestCounter_Range(t *testing.T) {
+	counter := New(1, 1, 2, 3, 1, 3)
+	counter.Range(func(key int, value int) {
+		assert.Equal(t, counter.counts[key], value)
+	})
+}
+
+func TestCounter_Delete(t *testing.T) {
+	counter := New("a", "a", "b")
+	counter.Delete("a")
+	assert.Equal(t, 0, counter.Get("a"))
+	assert.Equal(t, 1, counter.Size())
+}
@@ -0,0 +1,39 @@
+package counter_test
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/v2/maps/counter"
+)
+
+// User is a comparable struct and hence can be counted without implementing any interface
+type User struct {
+	ID   string
+	Name string
+}
+
+func Example_counter() {
+	user1 := User{ID: "1", Name: "Steve Rogers"}
+	user2 := User{ID: "2", Name: "Tony Stark"}
+	user3 := User{ID: "3", Name: "Natasha Romanoff"}
+
+	// Initialise the Counter, the key type is inferred from the elements
+	c := counter.New(user1, user2, user3)
+	c.Add(user1)
+	c.AddMany(user2, user3)
+	// Each subtract decreases the counter for the object. The count can be less than zero.
+	c.Subtract(user2)
+
+	fmt.Println(c.Get(user1))
+	fmt.Println(c.Get(user2))
+
+	// Completely removes the key from the counter
+	c.Delete(user2)
+	fmt.Println(c.Get(user2))
+	fmt.Println(c.Size())
+
+	// Output:
+	// 2
+	// 1
+	// 0
+	// 2
+}
@@ -0,0 +1,26 @@
+package queue_test
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/v2/queue"
+)
+
+func Example() {
+	// Create a new queue with some initial data, the element type is inferred as string
+	q := queue.New("a", "b", "c")
+
+	// Retrieve the next element in the queue
+	fmt.Println(q.Dequeue())
+
+	// Add new element to the queue
+	q.Enqueue("d")
+
+	// Inspect the next & the last added elements in the queue without popping them
+	fmt.Println(q.Front())
+	fmt.Println(q.Back())
+
+	// Output:
+	// a true
+	// b true
+	// d true
+}
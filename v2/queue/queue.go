@@ -0,0 +1,84 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package queue exposes adaptors for FIFO (first-in first-out) data-structure.
+//
+// Queues are a type of container adaptor, specifically designed to operate in a FIFO context (first-in first-out),
+// where elements are inserted into one end of the container and extracted from the other.
+//
+// Elements are pushed into the "back" of the specific container and popped from its "front".
+package queue
+
+import (
+	"github.com/soheltarir/gollections/v2/lists"
+)
+
+// A Queue is a linear structure which follows a particular order in which the operations are performed.
+// The order is First In First Out (FIFO)
+type Queue[T any] struct {
+	data *lists.List[T]
+}
+
+// Enqueue Inserts a new element at the end of the queue, after its current last element.
+func (q *Queue[T]) Enqueue(value T) {
+	q.data.PushBack(value)
+}
+
+// Dequeue Removes the next element in the queue, effectively reducing its size by one.
+// The element removed is the "oldest" element in the queue whose value can be retrieved by calling method Front().
+// The boolean is false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	return q.data.PopFront()
+}
+
+// Front Returns the next element in the queue.
+func (q *Queue[T]) Front() (T, bool) {
+	return q.data.Front()
+}
+
+// Back Returns the last element in the queue.
+// This is the "newest" element in the queue (i.e. the last element pushed into the queue).
+func (q *Queue[T]) Back() (T, bool) {
+	return q.data.Back()
+}
+
+// Size returns the total size of the queue
+func (q *Queue[T]) Size() int64 {
+	return q.data.Size()
+}
+
+// Empty returns true if the queue has no items
+func (q *Queue[T]) Empty() bool {
+	return q.data.Empty()
+}
+
+// Clear empties the queue
+func (q *Queue[T]) Clear() {
+	q.data.Clear()
+}
+
+// New instantiates a new queue with the items provided (order is preserved)
+func New[T any](values ...T) *Queue[T] {
+	return &Queue[T]{data: lists.New(values...)}
+}
@@ -0,0 +1,42 @@
+package queue
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	q := New(1, 2, 3)
+	assert.Equal(t, int64(3), q.Size())
+}
+
+func TestQueue_Enqueue(t *testing.T) {
+	q := New[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	assert.Equal(t, int64(2), q.Size())
+	back, _ := q.Back()
+	assert.Equal(t, 2, back)
+}
+
+func TestQueue_Dequeue(t *testing.T) {
+	q := New[string]()
+	_, ok := q.Dequeue()
+	assert.False(t, ok)
+	q.Enqueue("a")
+	q.Enqueue("b")
+	value, ok := q.Dequeue()
+	assert.True(t, ok)
+	assert.Equal(t, "a", value)
+	front, _ := q.Front()
+	assert.Equal(t, "b", front)
+}
+
+func TestQueue_Empty(t *testing.T) {
+	q := New[int]()
+	assert.True(t, q.Empty())
+	q.Enqueue(10)
+	assert.False(t, q.Empty())
+	q.Clear()
+	assert.True(t, q.Empty())
+}
@@ -0,0 +1,77 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package stack exposes adaptors for LIFO (last-in first-out) data-structure.
+//
+// Stacks are a type of container adaptor, specifically designed to operate in a LIFO context (last-in first-out),
+// where elements are inserted and extracted only from one end of the container.
+package stack
+
+import (
+	"github.com/soheltarir/gollections/v2/lists"
+)
+
+// Stack is a type of container adaptor, specifically designed to operate in a LIFO context (last-in first-out),
+// where elements are inserted and extracted only from one end of the container.
+type Stack[T any] struct {
+	data *lists.List[T]
+}
+
+// Push Inserts a new element at the top of the stack, above its current top element.
+func (s *Stack[T]) Push(value T) {
+	s.data.PushBack(value)
+}
+
+// Pop Removes the element on top of the stack, effectively reducing its size by one.
+// The element removed is the latest element inserted into the stack, whose value can be retrieved by calling
+// method Stack::Top. The boolean is false if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	return s.data.PopBack()
+}
+
+// Size Returns the number of elements in the stack.
+func (s *Stack[T]) Size() int64 {
+	return s.data.Size()
+}
+
+// Empty Returns whether the stack is empty: i.e. whether its size is zero.
+func (s *Stack[T]) Empty() bool {
+	return s.data.Empty()
+}
+
+// Top Returns the top element in the stack.
+// Since stacks are last-in first-out containers, the top element is the last element inserted into the stack.
+func (s *Stack[T]) Top() (T, bool) {
+	return s.data.Back()
+}
+
+// Clear deletes all the elements in the stack, effectively reducing its size to 0
+func (s *Stack[T]) Clear() {
+	s.data.Clear()
+}
+
+// New instantiates a fresh stack with the values provided
+func New[T any](values ...T) *Stack[T] {
+	return &Stack[T]{data: lists.New(values...)}
+}
@@ -0,0 +1,64 @@
+package stack
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	s := New(1, 2, 3)
+	assert.Equal(t, int64(3), s.Size())
+}
+
+func TestStack_Pop(t *testing.T) {
+	s := New[int]()
+	_, ok := s.Pop()
+	assert.False(t, ok)
+
+	s.Push(1)
+	s.Push(2)
+	value, ok := s.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, int64(1), s.Size())
+}
+
+func TestStack_Top(t *testing.T) {
+	s := New[string]()
+	_, ok := s.Top()
+	assert.False(t, ok)
+	s.Push("a")
+	s.Push("b")
+	top, _ := s.Top()
+	assert.Equal(t, "b", top)
+}
+
+func TestStack_Clear(t *testing.T) {
+	s := New("a", "b", "c")
+	assert.False(t, s.Empty())
+	s.Clear()
+	assert.True(t, s.Empty())
+}
+
+func Example() {
+	// Create a new stack, the element type is inferred as string
+	stack := New("a", "b", "c")
+
+	// Retrieve the next element in stack
+	top, _ := stack.Pop()
+	fmt.Println(top)
+
+	// Add a new element in the stack
+	stack.Push("d")
+	top, _ = stack.Top()
+	fmt.Println(top)
+
+	// Check the size of the stack
+	fmt.Println(stack.Size())
+
+	// Output:
+	// c
+	// d
+	// 3
+}
@@ -0,0 +1,33 @@
+package binarytrees_test
+
+import (
+	"fmt"
+	"github.com/soheltarir/gollections/v2/trees/binarytrees"
+)
+
+// User can be stored in the tree as is, without implementing any interface
+type User struct {
+	ID   string
+	Name string
+}
+
+func ExampleTree() {
+	tree := binarytrees.New[User]()
+	tree.InsertMany(
+		User{ID: "1", Name: "Steve Rogers"},
+		User{ID: "2", Name: "Tony Stark"},
+		User{ID: "3", Name: "Natasha Romanoff"},
+	)
+	fmt.Println(tree.Height)
+
+	// Iterate through the tree using breadth-first search technique
+	for it := tree.BreadthFirstTraverse(); it != tree.End(); it = it.Next() {
+		fmt.Println(it.Value().Name)
+	}
+
+	// Output:
+	// 2
+	// Steve Rogers
+	// Tony Stark
+	// Natasha Romanoff
+}
@@ -0,0 +1,131 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package binarytrees
+
+import "github.com/soheltarir/gollections/v2/lists"
+
+// Iterator is a stateful iterator for traversing a binary tree.
+// Please note, that iteration over a tree is not a thread-safe operation, and if parallel write operations are
+// being performed on the tree, the traversal can provide stale and outdated data.
+type Iterator[T any] struct {
+	tree        *Tree[T]
+	currentNode *Node[T]
+	visited     *lists.List[*Node[T]]
+}
+
+// Next returns the iterator to the next node in the binary tree based on the breadth-first traversal technique.
+func (it *Iterator[T]) Next() *Iterator[T] {
+	if it.currentNode == nil {
+		return it.tree.End()
+	}
+	if it.currentNode.Left != nil {
+		it.visited.PushBack(it.currentNode.Left)
+	}
+	if it.currentNode.Right != nil {
+		it.visited.PushBack(it.currentNode.Right)
+	}
+	next, ok := it.visited.PopFront()
+	if !ok {
+		return it.tree.End()
+	}
+	it.currentNode = next
+	return it
+}
+
+// Value returns the current node's data
+func (it *Iterator[T]) Value() T {
+	return it.currentNode.Value
+}
+
+// BreadthFirstTraverse returns an iterator pointing to the root of the binary tree. The iterator is
+// initialised in such a way that subsequent iterators (by calling Next()) returns tree nodes following the
+// breadth-first traversal algorithm. Refer https://en.wikipedia.org/wiki/Breadth-first_search to know more.
+func (t *Tree[T]) BreadthFirstTraverse() *Iterator[T] {
+	if t.Root == nil {
+		return t.End()
+	}
+	return &Iterator[T]{tree: t, currentNode: t.Root, visited: lists.New[*Node[T]]()}
+}
+
+// End returns an iterator to the past-the-end node in the binary tree.
+func (t *Tree[T]) End() *Iterator[T] {
+	return t.end
+}
+
+// inorderTraversalAuxiliary is a recursive function to traverse the tree InOrder depth first
+func inorderTraversalAuxiliary[T any](node *Node[T], result []T) []T {
+	if node == nil {
+		return result
+	}
+	result = inorderTraversalAuxiliary(node.Left, result)
+	result = append(result, node.Value)
+	return inorderTraversalAuxiliary(node.Right, result)
+}
+
+// preorderTraversalAuxiliary is a recursive function to traverse the tree PreOrder depth first
+func preorderTraversalAuxiliary[T any](node *Node[T], result []T) []T {
+	if node == nil {
+		return result
+	}
+	result = append(result, node.Value)
+	result = preorderTraversalAuxiliary(node.Left, result)
+	return preorderTraversalAuxiliary(node.Right, result)
+}
+
+// postorderTraversalAuxiliary is a recursive function to traverse the tree PostOrder depth first
+func postorderTraversalAuxiliary[T any](node *Node[T], result []T) []T {
+	if node == nil {
+		return result
+	}
+	result = postorderTraversalAuxiliary(node.Left, result)
+	result = postorderTraversalAuxiliary(node.Right, result)
+	return append(result, node.Value)
+}
+
+/*****************************************************************************************************/
+
+// InOrderTraversal returns the values of the tree traversed InOrder depth first
+func (t *Tree[T]) InOrderTraversal() []T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return inorderTraversalAuxiliary(t.Root, nil)
+}
+
+// PreOrderTraversal returns the values of the tree traversed PreOrder depth first
+func (t *Tree[T]) PreOrderTraversal() []T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return preorderTraversalAuxiliary(t.Root, nil)
+}
+
+// PostOrderTraversal returns the values of the tree traversed PostOrder depth first
+func (t *Tree[T]) PostOrderTraversal() []T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return postorderTraversalAuxiliary(t.Root, nil)
+}
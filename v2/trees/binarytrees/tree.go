@@ -0,0 +1,90 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package binarytrees
+
+import (
+	"github.com/soheltarir/gollections/v2/queue"
+	"sync"
+)
+
+// Tree defines the structure of a binary tree
+type Tree[T any] struct {
+	Root   *Node[T]
+	Height int
+	// end is the past-the-end iterator of the tree
+	end *Iterator[T]
+	mu  sync.RWMutex
+}
+
+// Insert adds a node in the tree, filling up the tree level by level from left to right.
+func (t *Tree[T]) Insert(value T) {
+	newNode := &Node[T]{Value: value}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Root == nil {
+		t.Root = newNode
+		t.Height++
+		return
+	}
+	// Do a level order traversal until we find an empty place
+	currHeight := 0
+	q := queue.New(t.Root)
+	for !q.Empty() {
+		currNode, _ := q.Dequeue()
+		currHeight++
+		if currNode.Left == nil {
+			currNode.Left = newNode
+			if currHeight >= t.Height {
+				t.Height++
+			}
+			break
+		} else {
+			q.Enqueue(currNode.Left)
+		}
+		if currNode.Right == nil {
+			currNode.Right = newNode
+			// We don't need to increment height here, as we are filling up the left leaf first.
+			break
+		} else {
+			q.Enqueue(currNode.Right)
+		}
+	}
+}
+
+// InsertMany adds multiple nodes to the tree (order is preserved).
+func (t *Tree[T]) InsertMany(values ...T) {
+	for _, value := range values {
+		t.Insert(value)
+	}
+}
+
+// New instantiates a binary tree
+func New[T any]() *Tree[T] {
+	tree := &Tree[T]{}
+	tree.end = &Iterator[T]{}
+	return tree
+}
@@ -0,0 +1,49 @@
+package binarytrees
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTree_Insert(t *testing.T) {
+	tree := New[int]()
+	tree.Insert(10)
+	assert.Equal(t, 10, tree.Root.Value)
+}
+
+func TestTree_Height(t *testing.T) {
+	tree := New[int]()
+	tree.InsertMany(10, 11, 5, 4, 9)
+	assert.Equal(t, 3, tree.Height)
+}
+
+func TestTree_InOrderTraversal(t *testing.T) {
+	tree := New[int]()
+	assert.Empty(t, tree.InOrderTraversal())
+	tree.InsertMany(10, 9, 6, 5, 11, 20)
+	assert.Equal(t, []int{5, 9, 11, 10, 20, 6}, tree.InOrderTraversal())
+}
+
+func TestTree_PreOrderTraversal(t *testing.T) {
+	tree := New[int]()
+	tree.InsertMany(10, 9, 6, 5, 11, 20)
+	assert.Equal(t, []int{10, 9, 5, 11, 6, 20}, tree.PreOrderTraversal())
+}
+
+func TestTree_PostOrderTraversal(t *testing.T) {
+	tree := New[int]()
+	tree.InsertMany(10, 9, 6, 5, 11, 20)
+	assert.Equal(t, []int{5, 11, 9, 20, 6, 10}, tree.PostOrderTraversal())
+}
+
+func TestTree_BreadthFirstTraverse(t *testing.T) {
+	tree := New[string]()
+	assert.Equal(t, tree.End(), tree.BreadthFirstTraverse())
+
+	tree.InsertMany("a", "b", "c", "d")
+	var actual []string
+	for it := tree.BreadthFirstTraverse(); it != tree.End(); it = it.Next() {
+		actual = append(actual, it.Value())
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, actual)
+}
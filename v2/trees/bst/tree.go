@@ -0,0 +1,102 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package bst exposes the Binary Search Tree container, refer https://en.wikipedia.org/wiki/Binary_search_tree to know
+// more about the container.
+package bst
+
+import (
+	"github.com/soheltarir/gollections/v2/containers"
+	"github.com/soheltarir/gollections/v2/queue"
+	"github.com/soheltarir/gollections/v2/trees/binarytrees"
+	"sync"
+)
+
+// Tree is a binary tree wherein the value of each node is greater than all the values in its left subtree and
+// less than or equal to all the values in its right subtree, as reported by the tree's comparator.
+type Tree[T any] struct {
+	*binarytrees.Tree[T]
+	less containers.LessFunc[T]
+	mu   sync.RWMutex
+}
+
+// Insert adds a new node at the leaf.
+// - Time Complexity: O(log(n))
+// - Space Complexity: O(log(n))
+func (t *Tree[T]) Insert(value T) {
+	newNode := &binarytrees.Node[T]{Value: value}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var height int
+	t.Root, height = insertToTree(t.Root, newNode, t.less, 0)
+	if height > t.Height {
+		t.Height = height
+	}
+}
+
+// InsertMany adds multiple nodes to the tree (order is preserved).
+func (t *Tree[T]) InsertMany(values ...T) {
+	for _, value := range values {
+		t.Insert(value)
+	}
+}
+
+// BreadthFirstSearch traverses the tree across breadth. For more refer: https://en.wikipedia.org/wiki/Breadth-first_search
+//
+// - Time Complexity: O(n)
+// - Space Complexity: O(n)
+func (t *Tree[T]) BreadthFirstSearch() []T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var values []T
+	if t.Root == nil {
+		return values
+	}
+	// queue to store visited nodes
+	q := queue.New(t.Root)
+	for !q.Empty() {
+		currentNode, _ := q.Dequeue()
+		values = append(values, currentNode.Value)
+		if currentNode.Left != nil {
+			q.Enqueue(currentNode.Left)
+		}
+		if currentNode.Right != nil {
+			q.Enqueue(currentNode.Right)
+		}
+	}
+	return values
+}
+
+// NewFunc returns a binary search tree ordered by the comparator provided
+func NewFunc[T any](less containers.LessFunc[T]) *Tree[T] {
+	return &Tree[T]{Tree: binarytrees.New[T](), less: less}
+}
+
+// New returns a binary search tree ordered by the < operator
+func New[T containers.Ordered]() *Tree[T] {
+	return NewFunc(containers.Less[T])
+}
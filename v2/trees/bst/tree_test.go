@@ -0,0 +1,50 @@
+package bst
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tree := New[string]()
+	tree.InsertMany("b", "a", "c")
+	assert.Equal(t, []string{"a", "b", "c"}, tree.InOrderTraversal())
+}
+
+func TestNewFunc(t *testing.T) {
+	type user struct {
+		ID   string
+		Name string
+	}
+	tree := NewFunc(func(a, b user) bool { return a.Name < b.Name })
+	tree.InsertMany(user{ID: "1", Name: "B"}, user{ID: "2", Name: "A"})
+	assert.Equal(t, "A", tree.Root.Left.Value.Name)
+}
+
+func TestTree_Insert(t *testing.T) {
+	tree := New[int]()
+	tree.Insert(10)
+	tree.Insert(1)
+	tree.Insert(11)
+	assert.Equal(t, 2, tree.Height)
+	tree.Insert(12)
+	tree.Insert(0)
+	assert.Equal(t, 3, tree.Height)
+}
+
+func TestTree_BreadthFirstSearch(t *testing.T) {
+	tree := New[int]()
+	assert.Empty(t, tree.BreadthFirstSearch())
+	tree.InsertMany(10, 1, 11, 3, 4, 12)
+	assert.Equal(t, []int{10, 1, 11, 3, 12, 4}, tree.BreadthFirstSearch())
+}
+
+func TestTree_BreadthFirstTraverse(t *testing.T) {
+	tree := New[int]()
+	tree.InsertMany(2, 1, 3)
+	var actual []int
+	for it := tree.BreadthFirstTraverse(); it != tree.End(); it = it.Next() {
+		actual = append(actual, it.Value())
+	}
+	assert.Equal(t, []int{2, 1, 3}, actual)
+}
@@ -0,0 +1,115 @@
+/**
+MIT License
+
+Copyright (c) 2021 Sohel Tarir
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package heaps exposes the binary heap data-structure, refer https://en.wikipedia.org/wiki/Binary_heap to know more.
+package heaps
+
+import (
+	"container/heap"
+	"github.com/soheltarir/gollections/v2/containers"
+	"sync"
+)
+
+// store adapts the heap's elements to container/heap's heap.Interface.
+type store[T any] struct {
+	data []T
+	less containers.LessFunc[T]
+}
+
+func (s *store[T]) Len() int { return len(s.data) }
+
+func (s *store[T]) Less(i, j int) bool { return s.less(s.data[i], s.data[j]) }
+
+func (s *store[T]) Swap(i, j int) { s.data[i], s.data[j] = s.data[j], s.data[i] }
+
+func (s *store[T]) Push(x interface{}) { s.data = append(s.data, x.(T)) }
+
+func (s *store[T]) Pop() interface{} {
+	n := len(s.data)
+	popped := s.data[n-1]
+	s.data = s.data[0 : n-1]
+	return popped
+}
+
+// Heap is a binary heap ordered by the comparator provided during instantiation. The element for which the
+// comparator reports less than all the other elements is always at the top of the heap.
+type Heap[T any] struct {
+	s  store[T]
+	mu sync.RWMutex
+}
+
+// Len returns the number of elements in the heap
+func (h *Heap[T]) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.s.Len()
+}
+
+// Insert allows both single & multiple elements to be added to the heap.
+// Time complexity for adding a single element is O(log(n)).
+// Time complexity for adding multiple elements is O(n)
+func (h *Heap[T]) Insert(values ...T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(values) == 1 {
+		heap.Push(&h.s, values[0])
+	} else {
+		h.s.data = append(h.s.data, values...)
+		heap.Init(&h.s)
+	}
+}
+
+// Extract removes and returns the top element of the heap.
+// The boolean is false if the heap is empty.
+func (h *Heap[T]) Extract() (T, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.s.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&h.s).(T), true
+}
+
+// New instantiates a heap ordered by the comparator provided
+func New[T any](less containers.LessFunc[T], elements ...T) *Heap[T] {
+	h := &Heap[T]{s: store[T]{less: less}}
+	if len(elements) > 0 {
+		h.Insert(elements...)
+	}
+	return h
+}
+
+// NewMin instantiates a min heap, i.e., the smallest element is at the top of the heap
+func NewMin[T containers.Ordered](elements ...T) *Heap[T] {
+	return New(containers.Less[T], elements...)
+}
+
+// NewMax instantiates a max heap, i.e., the largest element is at the top of the heap
+func NewMax[T containers.Ordered](elements ...T) *Heap[T] {
+	return New(containers.Greater[T], elements...)
+}
@@ -0,0 +1,49 @@
+package heaps
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewMin(t *testing.T) {
+	heap := NewMin(1, 10, 3, 6)
+	assert.Equal(t, 1, heap.s.data[0])
+	assert.Equal(t, 4, heap.Len())
+}
+
+func TestNewMax(t *testing.T) {
+	heap := NewMax(1, 10, 3, 6)
+	assert.Equal(t, 10, heap.s.data[0])
+}
+
+func TestNew(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	heap := New(func(a, b task) bool { return a.priority > b.priority },
+		task{"a", 1}, task{"b", 5}, task{"c", 3})
+	top, ok := heap.Extract()
+	assert.True(t, ok)
+	assert.Equal(t, "b", top.name)
+}
+
+func TestHeap_Insert(t *testing.T) {
+	heap := NewMax[int]()
+	heap.Insert(10)
+	heap.Insert(1)
+	heap.Insert(20)
+	assert.Equal(t, 20, heap.s.data[0])
+}
+
+func TestHeap_Extract(t *testing.T) {
+	heap := NewMin(10, 20, 30, 5)
+	var actual []int
+	for heap.Len() > 0 {
+		value, _ := heap.Extract()
+		actual = append(actual, value)
+	}
+	assert.Equal(t, []int{5, 10, 20, 30}, actual)
+	_, ok := heap.Extract()
+	assert.False(t, ok)
+}